@@ -0,0 +1,21 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var (
+	eventsAPIURL string
+)
+
+var eventsCmd = &cobra.Command{
+	Use:   "events",
+	Short: "Query the event history",
+	Long:  `Query the event journal of a running monitor through its api.`,
+}
+
+func init() {
+	rootCmd.AddCommand(eventsCmd)
+
+	eventsCmd.PersistentFlags().StringVar(&eventsAPIURL, "api-url", "http://localhost:9292", "Monitor api URL")
+}
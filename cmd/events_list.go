@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/journal"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var (
+	eventsListGroup string
+	eventsListType  string
+	eventsListSince string
+	eventsListLimit int
+)
+
+var listEventsCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List events",
+	Long:  `List the events recorded by a running monitor, newest first.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		initCommon()
+
+		err := listEvents(cmd.Context())
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	eventsCmd.AddCommand(listEventsCmd)
+
+	listEventsCmd.Flags().StringVar(&eventsListGroup, "group", "", "Only list events of this group")
+	listEventsCmd.Flags().StringVar(&eventsListType, "type", "", "Only list events of this type, alert types include their reminders and resolved events")
+	listEventsCmd.Flags().StringVar(&eventsListSince, "since", "", "Only list events since this RFC3339 timestamp or duration, eg. 24h")
+	listEventsCmd.Flags().IntVar(&eventsListLimit, "limit", 100, "Maximum number of events to list, 0 lists every event")
+}
+
+func listEvents(ctx context.Context) error {
+	// validate locally so a typo doesn't need a round trip to the monitor
+	if _, err := journal.ParseSince(eventsListSince, time.Now()); err != nil {
+		return err
+	}
+
+	params := url.Values{}
+
+	if eventsListGroup != "" {
+		params.Set("group", eventsListGroup)
+	}
+
+	if eventsListType != "" {
+		params.Set("type", eventsListType)
+	}
+
+	if eventsListSince != "" {
+		params.Set("since", eventsListSince)
+	}
+
+	params.Set("limit", strconv.Itoa(eventsListLimit))
+
+	var entries []*journal.Entry
+	if err := apiRequest(ctx, eventsAPIURL, "", http.MethodGet, "/api/v1/events?"+params.Encode(), nil, &entries); err != nil {
+		return err
+	}
+
+	if len(entries) == 0 {
+		log.Info("No events")
+
+		return nil
+	}
+
+	for _, entry := range entries {
+		logEntry(entry).Info(entry.Title)
+	}
+
+	return nil
+}
+
+func logEntry(entry *journal.Entry) *logrus.Entry {
+	fields := logrus.Fields{
+		"id":          entry.ID,
+		"recorded_at": entry.RecordedAt.UTC().Format(time.RFC3339),
+		"type":        entry.Event.Type,
+		"group":       entry.Event.Group,
+		"severity":    entry.Event.Severity,
+		"subject":     entry.Event.Subject,
+		"silenced":    entry.Silenced,
+	}
+
+	if entry.ResolvedAt != nil {
+		fields["resolved_at"] = entry.ResolvedAt.UTC().Format(time.RFC3339)
+	}
+
+	return log.WithFields(fields)
+}
@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	silenceAPIURL   string
+	silenceAPIToken string
+)
+
+var silenceCmd = &cobra.Command{
+	Use:   "silence",
+	Short: "Manage silences",
+	Long:  `Add, list, or delete silences of a running monitor through its api.`,
+}
+
+func init() {
+	rootCmd.AddCommand(silenceCmd)
+
+	silenceCmd.PersistentFlags().StringVar(&silenceAPIURL, "api-url", "http://localhost:9292", "Monitor api URL")
+	silenceCmd.PersistentFlags().StringVar(&silenceAPIToken, "api-token", os.Getenv("SPLITOOR_API_TOKEN"), "Monitor api token, required to add or delete silences (env SPLITOOR_API_TOKEN)")
+}
+
+// silenceRequest calls the silences api of a running monitor and decodes the response into out.
+func silenceRequest(ctx context.Context, method, path string, body io.Reader, out interface{}) error {
+	return apiRequest(ctx, silenceAPIURL, silenceAPIToken, method, "/api/v1/silences"+path, body, out)
+}
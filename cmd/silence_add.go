@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/silence"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var (
+	silenceAddMonitor   string
+	silenceAddGroup     string
+	silenceAddEventType string
+	silenceAddSubject   string
+	silenceAddStart     string
+	silenceAddEnd       string
+	silenceAddDuration  time.Duration
+	silenceAddComment   string
+	silenceAddCreatedBy string
+)
+
+var addSilenceCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Add a silence",
+	Long:  `Add a silence that suppresses notifications of matching events. Matchers are optional and support glob patterns, eg. split_hash_*.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		initCommon()
+
+		err := addSilence(cmd.Context())
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	silenceCmd.AddCommand(addSilenceCmd)
+
+	addSilenceCmd.Flags().StringVar(&silenceAddMonitor, "monitor", "", "Monitor name to match")
+	addSilenceCmd.Flags().StringVar(&silenceAddGroup, "group", "", "Group name to match")
+	addSilenceCmd.Flags().StringVar(&silenceAddEventType, "type", "", "Event type to match, eg. split_hash_recovery_state")
+	addSilenceCmd.Flags().StringVar(&silenceAddSubject, "subject", "", "Split address or validator pubkey to match")
+	addSilenceCmd.Flags().StringVar(&silenceAddStart, "start", "", "Start of the silence in RFC3339 format (default now)")
+	addSilenceCmd.Flags().StringVar(&silenceAddEnd, "end", "", "End of the silence in RFC3339 format, takes precedence over --duration")
+	addSilenceCmd.Flags().DurationVar(&silenceAddDuration, "duration", time.Hour, "Duration of the silence")
+	addSilenceCmd.Flags().StringVar(&silenceAddComment, "comment", "", "Reason for the silence")
+	addSilenceCmd.Flags().StringVar(&silenceAddCreatedBy, "created-by", os.Getenv("USER"), "Creator of the silence")
+}
+
+func addSilence(ctx context.Context) error {
+	start := time.Now()
+
+	if silenceAddStart != "" {
+		t, err := time.Parse(time.RFC3339, silenceAddStart)
+		if err != nil {
+			return fmt.Errorf("invalid start: %w", err)
+		}
+
+		start = t
+	}
+
+	end := start.Add(silenceAddDuration)
+
+	if silenceAddEnd != "" {
+		t, err := time.Parse(time.RFC3339, silenceAddEnd)
+		if err != nil {
+			return fmt.Errorf("invalid end: %w", err)
+		}
+
+		end = t
+	}
+
+	s := silence.Silence{
+		Monitor:   silenceAddMonitor,
+		Group:     silenceAddGroup,
+		EventType: silenceAddEventType,
+		Subject:   silenceAddSubject,
+		Start:     start,
+		End:       end,
+		Comment:   silenceAddComment,
+		CreatedBy: silenceAddCreatedBy,
+	}
+
+	if err := s.Validate(); err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	var created silence.Silence
+	if err := silenceRequest(ctx, http.MethodPost, "", bytes.NewReader(body), &created); err != nil {
+		return err
+	}
+
+	logSilence(&created).Info("Silence added")
+
+	return nil
+}
+
+func logSilence(s *silence.Silence) *logrus.Entry {
+	return log.WithFields(logrus.Fields{
+		"id":         s.ID,
+		"state":      s.GetState(time.Now()),
+		"monitor":    s.Monitor,
+		"group":      s.Group,
+		"event_type": s.EventType,
+		"subject":    s.Subject,
+		"start":      s.Start.UTC().Format(time.RFC3339),
+		"end":        s.End.UTC().Format(time.RFC3339),
+		"comment":    s.Comment,
+		"created_by": s.CreatedBy,
+		"static":     s.Static,
+	})
+}
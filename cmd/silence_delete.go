@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/spf13/cobra"
+)
+
+var deleteSilenceCmd = &cobra.Command{
+	Use:   "delete [id]",
+	Short: "Delete a silence",
+	Long:  `Delete a silence that was added at runtime, silences from the config file can't be deleted.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		initCommon()
+
+		err := deleteSilence(cmd.Context(), args[0])
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	silenceCmd.AddCommand(deleteSilenceCmd)
+}
+
+func deleteSilence(ctx context.Context, id string) error {
+	if err := silenceRequest(ctx, http.MethodDelete, "/"+url.PathEscape(id), nil, nil); err != nil {
+		return err
+	}
+
+	log.WithField("id", id).Info("Silence deleted")
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/silence"
+	"github.com/spf13/cobra"
+)
+
+var (
+	silenceListAll bool
+)
+
+var listSilenceCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List silences",
+	Long:  `List the pending and active silences of a running monitor.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		initCommon()
+
+		err := listSilences(cmd.Context())
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	silenceCmd.AddCommand(listSilenceCmd)
+
+	listSilenceCmd.Flags().BoolVar(&silenceListAll, "all", false, "Include expired silences")
+}
+
+func listSilences(ctx context.Context) error {
+	path := ""
+	if silenceListAll {
+		path = "?all=true"
+	}
+
+	var silences []*silence.Silence
+	if err := silenceRequest(ctx, http.MethodGet, path, nil, &silences); err != nil {
+		return err
+	}
+
+	if len(silences) == 0 {
+		log.Info("No silences")
+
+		return nil
+	}
+
+	for _, s := range silences {
+		logSilence(s).Info("Silence")
+	}
+
+	return nil
+}
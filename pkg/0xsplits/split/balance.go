@@ -32,3 +32,29 @@ func (c *Client) GetETHBalance(ctx context.Context, node *execution.Node, contra
 
 	return bigBalance, nil
 }
+
+// GetERC20Balance returns the token balance of the account held by SplitMain, ie. distributed
+// but not yet withdrawn.
+func (c *Client) GetERC20Balance(ctx context.Context, node *execution.Node, contractABI *ethcoder.ABI, address, token string) (*big.Int, error) {
+	calldata, err := contractABI.EncodeMethodCalldataFromStringValues("getERC20Balance", []string{address, token})
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := node.ReadContract(ctx, c.contractAddress, calldata, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := contractABI.RawABI().Methods["getERC20Balance"].Outputs.UnpackValues(balance)
+	if err != nil {
+		return nil, err
+	}
+
+	bigBalance, ok := values[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("invalid balance")
+	}
+
+	return bigBalance, nil
+}
@@ -0,0 +1,429 @@
+package split
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"sort"
+
+	"github.com/0xsequence/ethkit/ethcoder"
+	"github.com/0xsequence/ethkit/go-ethereum"
+	"github.com/0xsequence/ethkit/go-ethereum/common"
+	"github.com/0xsequence/ethkit/go-ethereum/core/types"
+	"github.com/ethpandaops/splitoor/pkg/ethereum/execution"
+)
+
+// SplitMain events emitted for a split.
+const (
+	EventCreateSplit             = "CreateSplit"
+	EventUpdateSplit             = "UpdateSplit"
+	EventInitiateControlTransfer = "InitiateControlTransfer"
+	EventCancelControlTransfer   = "CancelControlTransfer"
+	EventControlTransfer         = "ControlTransfer"
+	EventDistributeETH           = "DistributeETH"
+	EventWithdrawal              = "Withdrawal"
+)
+
+var (
+	// splitEvents are indexed by the split address.
+	splitEvents = []string{
+		EventUpdateSplit,
+		EventInitiateControlTransfer,
+		EventCancelControlTransfer,
+		EventControlTransfer,
+		EventDistributeETH,
+	}
+
+	// recipientEvents are emitted when the recipients of a split are set.
+	recipientEvents = []string{
+		EventCreateSplit,
+		EventUpdateSplit,
+	}
+
+	// updateMethods are the SplitMain methods that set the recipients of a split.
+	updateMethods = []string{
+		"updateSplit",
+		"updateAndDistributeETH",
+		"updateAndDistributeERC20",
+	}
+
+	// createMethod has no split argument, the split is the one created by the transaction.
+	createMethod = "createSplit"
+)
+
+// ContractEvent is a decoded SplitMain log.
+type ContractEvent struct {
+	Name        string
+	Split       string
+	TxHash      string
+	BlockNumber uint64
+	LogIndex    uint
+	// Controller is the new (potential) controller of control transfer events.
+	Controller string
+	// PreviousController is the controller before a control transfer.
+	PreviousController string
+	// Account is the address that withdrew of withdrawal events.
+	Account string
+	// Distributor is the address that receives the distributor fee of distribute events.
+	Distributor string
+	// Amount is the ETH amount in wei of distribute and withdrawal events.
+	Amount *big.Int
+}
+
+// Recipients are the accounts and allocations a split was updated to.
+type Recipients struct {
+	Accounts              []string
+	PercentageAllocations []uint32
+	DistributorFee        uint32
+}
+
+// GetContractEvents returns the SplitMain events of the split between fromBlock and
+// toBlock inclusive, ordered by block and log index. Withdrawals are not indexed by split
+// so they are returned for the given accounts instead.
+func (c *Client) GetContractEvents(ctx context.Context, node *execution.Node, contractABI *ethcoder.ABI, fromBlock, toBlock uint64, accounts []string) ([]*ContractEvent, error) {
+	if c.splitAddress == nil {
+		return nil, fmt.Errorf("split address is required")
+	}
+
+	rawABI := contractABI.RawABI()
+	names := map[common.Hash]string{}
+	topics := []common.Hash{}
+
+	for _, name := range splitEvents {
+		e, ok := rawABI.Events[name]
+		if !ok {
+			return nil, fmt.Errorf("event %s not found in abi", name)
+		}
+
+		names[e.ID] = name
+		topics = append(topics, e.ID)
+	}
+
+	contractAddress := common.HexToAddress(c.contractAddress)
+	from := new(big.Int).SetUint64(fromBlock)
+	to := new(big.Int).SetUint64(toBlock)
+
+	logs, err := node.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: from,
+		ToBlock:   to,
+		Addresses: []common.Address{contractAddress},
+		Topics:    [][]common.Hash{topics, {addressTopic(*c.splitAddress)}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(accounts) > 0 {
+		withdrawal, ok := rawABI.Events[EventWithdrawal]
+		if !ok {
+			return nil, fmt.Errorf("event %s not found in abi", EventWithdrawal)
+		}
+
+		names[withdrawal.ID] = EventWithdrawal
+
+		accountTopics := make([]common.Hash, len(accounts))
+		for i, account := range accounts {
+			accountTopics[i] = addressTopic(account)
+		}
+
+		withdrawals, err := node.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: from,
+			ToBlock:   to,
+			Addresses: []common.Address{contractAddress},
+			Topics:    [][]common.Hash{{withdrawal.ID}, accountTopics},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		logs = append(logs, withdrawals...)
+	}
+
+	events := make([]*ContractEvent, 0, len(logs))
+
+	for i := range logs {
+		l := &logs[i]
+
+		if l.Removed || len(l.Topics) == 0 {
+			continue
+		}
+
+		name, ok := names[l.Topics[0]]
+		if !ok {
+			continue
+		}
+
+		e, err := decodeContractEvent(contractABI, name, l)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s log of tx %s: %w", name, l.TxHash.Hex(), err)
+		}
+
+		if e.Split == "" {
+			e.Split = common.HexToAddress(*c.splitAddress).Hex()
+		}
+
+		events = append(events, e)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].BlockNumber != events[j].BlockNumber {
+			return events[i].BlockNumber < events[j].BlockNumber
+		}
+
+		return events[i].LogIndex < events[j].LogIndex
+	})
+
+	return events, nil
+}
+
+func decodeContractEvent(contractABI *ethcoder.ABI, name string, l *types.Log) (*ContractEvent, error) {
+	e := &ContractEvent{
+		Name:        name,
+		TxHash:      l.TxHash.Hex(),
+		BlockNumber: l.BlockNumber,
+		LogIndex:    l.Index,
+	}
+
+	topic := func(i int) (string, error) {
+		if len(l.Topics) <= i {
+			return "", fmt.Errorf("missing topic %d", i)
+		}
+
+		return common.BytesToAddress(l.Topics[i].Bytes()).Hex(), nil
+	}
+
+	var err error
+
+	if name == EventWithdrawal {
+		e.Account, err = topic(1)
+	} else {
+		e.Split, err = topic(1)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch name {
+	case EventInitiateControlTransfer:
+		e.Controller, err = topic(2)
+	case EventControlTransfer:
+		e.PreviousController, err = topic(2)
+		if err == nil {
+			e.Controller, err = topic(3)
+		}
+	case EventDistributeETH:
+		e.Distributor, err = topic(2)
+		if err == nil {
+			e.Amount, err = decodeAmount(contractABI, name, l.Data)
+		}
+	case EventWithdrawal:
+		e.Amount, err = decodeAmount(contractABI, name, l.Data)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// decodeAmount returns the first non-indexed uint256 of the log data.
+func decodeAmount(contractABI *ethcoder.ABI, name string, data []byte) (*big.Int, error) {
+	values, err := contractABI.RawABI().Events[name].Inputs.NonIndexed().UnpackValues(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(values) == 0 {
+		return nil, fmt.Errorf("missing amount")
+	}
+
+	amount, ok := values[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("invalid amount")
+	}
+
+	return amount, nil
+}
+
+// GetRecipientEvents returns the CreateSplit and UpdateSplit events of the split between
+// fromBlock and toBlock inclusive, ordered by block and log index.
+func (c *Client) GetRecipientEvents(ctx context.Context, node *execution.Node, contractABI *ethcoder.ABI, fromBlock, toBlock uint64) ([]*ContractEvent, error) {
+	if c.splitAddress == nil {
+		return nil, fmt.Errorf("split address is required")
+	}
+
+	rawABI := contractABI.RawABI()
+	names := map[common.Hash]string{}
+	topics := []common.Hash{}
+
+	for _, name := range recipientEvents {
+		e, ok := rawABI.Events[name]
+		if !ok {
+			return nil, fmt.Errorf("event %s not found in abi", name)
+		}
+
+		names[e.ID] = name
+		topics = append(topics, e.ID)
+	}
+
+	logs, err := node.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(fromBlock),
+		ToBlock:   new(big.Int).SetUint64(toBlock),
+		Addresses: []common.Address{common.HexToAddress(c.contractAddress)},
+		Topics:    [][]common.Hash{topics, {addressTopic(*c.splitAddress)}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*ContractEvent, 0, len(logs))
+
+	for i := range logs {
+		l := &logs[i]
+
+		if l.Removed || len(l.Topics) == 0 {
+			continue
+		}
+
+		name, ok := names[l.Topics[0]]
+		if !ok {
+			continue
+		}
+
+		e, err := decodeContractEvent(contractABI, name, l)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s log of tx %s: %w", name, l.TxHash.Hex(), err)
+		}
+
+		events = append(events, e)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].BlockNumber != events[j].BlockNumber {
+			return events[i].BlockNumber < events[j].BlockNumber
+		}
+
+		return events[i].LogIndex < events[j].LogIndex
+	})
+
+	return events, nil
+}
+
+// DecodeRecipients returns the recipients the split is updated to by the transaction
+// input, or nil if the input doesn't update the split. Calls wrapped by another contract,
+// eg. a Safe execTransaction, are found by searching the input for the method selectors.
+func (c *Client) DecodeRecipients(contractABI *ethcoder.ABI, input []byte) (*Recipients, error) {
+	if c.splitAddress == nil {
+		return nil, fmt.Errorf("split address is required")
+	}
+
+	for _, name := range updateMethods {
+		if recipients := decodeRecipientCalls(contractABI, name, input, *c.splitAddress); len(recipients) > 0 {
+			return recipients[0], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// FindRecipients returns the recipients set by the transaction input that match the split
+// hash, or nil if there are none. Unlike DecodeRecipients it also decodes createSplit calls,
+// which don't include the split address, so the hash is used to tell the calls apart.
+func (c *Client) FindRecipients(contractABI *ethcoder.ABI, input, hash []byte) (*Recipients, error) {
+	if c.splitAddress == nil {
+		return nil, fmt.Errorf("split address is required")
+	}
+
+	names := append(slices.Clone(updateMethods), createMethod)
+
+	for _, name := range names {
+		for _, recipients := range decodeRecipientCalls(contractABI, name, input, *c.splitAddress) {
+			actual, err := recipients.Hash()
+			if err != nil {
+				continue
+			}
+
+			if bytes.Equal(actual, hash) {
+				return recipients, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// decodeRecipientCalls returns the recipients of every call of the method in the input.
+// Calls of methods with a split argument are only returned for the given split.
+func decodeRecipientCalls(contractABI *ethcoder.ABI, name string, input []byte, splitAddress string) []*Recipients {
+	method, ok := contractABI.RawABI().Methods[name]
+	if !ok {
+		return nil
+	}
+
+	split := common.HexToAddress(splitAddress)
+	calls := []*Recipients{}
+
+	for offset := 0; offset < len(input); {
+		idx := bytes.Index(input[offset:], method.ID)
+		if idx < 0 {
+			break
+		}
+
+		start := offset + idx + len(method.ID)
+		offset = start
+
+		values, err := method.Inputs.UnpackValues(input[start:])
+		if err != nil || len(values) != len(method.Inputs) {
+			continue
+		}
+
+		// the update methods share argument names but not positions
+		args := make(map[string]interface{}, len(values))
+		for i, input := range method.Inputs {
+			args[input.Name] = values[i]
+		}
+
+		if arg, exists := args["split"]; exists {
+			address, ok := arg.(common.Address)
+			if !ok || address != split {
+				continue
+			}
+		}
+
+		recipients, ok := args["accounts"].([]common.Address)
+		if !ok {
+			continue
+		}
+
+		allocations, ok := args["percentAllocations"].([]uint32)
+		if !ok || len(allocations) != len(recipients) {
+			continue
+		}
+
+		fee, ok := args["distributorFee"].(uint32)
+		if !ok {
+			continue
+		}
+
+		accounts := make([]string, len(recipients))
+		for i, r := range recipients {
+			accounts[i] = r.Hex()
+		}
+
+		calls = append(calls, &Recipients{
+			Accounts:              accounts,
+			PercentageAllocations: allocations,
+			DistributorFee:        fee,
+		})
+	}
+
+	return calls
+}
+
+func addressTopic(address string) common.Hash {
+	return common.BytesToHash(common.HexToAddress(address).Bytes())
+}
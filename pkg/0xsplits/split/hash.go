@@ -37,7 +37,7 @@ func CalculateHash(params *HashParams) ([]byte, error) {
 	data := encodePacked(
 		encodeAddressArrayPadded(params.Accounts),
 		encodeUint32ArrayPadded(params.PercentageAllocations),
-		encodeUint32NoPad(0),
+		encodeUint32NoPad(params.DistributorFee),
 	)
 
 	return crypto.Keccak256(data), nil
@@ -0,0 +1,85 @@
+package split
+
+import (
+	"sort"
+
+	"github.com/0xsequence/ethkit/go-ethereum/common"
+)
+
+// Recipient changes of a diff.
+const (
+	RecipientAdded   = "added"
+	RecipientRemoved = "removed"
+	RecipientChanged = "changed"
+)
+
+// RecipientChange is a difference between the expected and actual recipients of a split.
+type RecipientChange struct {
+	Change  string
+	Account string
+	// ExpectedAllocation is zero for added recipients.
+	ExpectedAllocation uint32
+	// ActualAllocation is zero for removed recipients.
+	ActualAllocation uint32
+}
+
+// Hash returns the split hash of the recipients.
+func (r *Recipients) Hash() ([]byte, error) {
+	return CalculateHash(&HashParams{
+		Accounts:              append([]string{}, r.Accounts...),
+		PercentageAllocations: append([]uint32{}, r.PercentageAllocations...),
+		DistributorFee:        r.DistributorFee,
+	})
+}
+
+// Diff returns the changes from the expected accounts and allocations to the recipients,
+// ordered by account. Accounts are compared case insensitively.
+func (r *Recipients) Diff(accounts []string, allocations []uint32) []*RecipientChange {
+	expected := make(map[common.Address]uint32, len(accounts))
+	for i, account := range accounts {
+		expected[common.HexToAddress(account)] += allocations[i]
+	}
+
+	actual := make(map[common.Address]uint32, len(r.Accounts))
+	for i, account := range r.Accounts {
+		actual[common.HexToAddress(account)] += r.PercentageAllocations[i]
+	}
+
+	changes := []*RecipientChange{}
+
+	for account, allocation := range actual {
+		expectedAllocation, ok := expected[account]
+
+		switch {
+		case !ok:
+			changes = append(changes, &RecipientChange{
+				Change:           RecipientAdded,
+				Account:          account.Hex(),
+				ActualAllocation: allocation,
+			})
+		case expectedAllocation != allocation:
+			changes = append(changes, &RecipientChange{
+				Change:             RecipientChanged,
+				Account:            account.Hex(),
+				ExpectedAllocation: expectedAllocation,
+				ActualAllocation:   allocation,
+			})
+		}
+	}
+
+	for account, allocation := range expected {
+		if _, ok := actual[account]; !ok {
+			changes = append(changes, &RecipientChange{
+				Change:             RecipientRemoved,
+				Account:            account.Hex(),
+				ExpectedAllocation: allocation,
+			})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Account < changes[j].Account
+	})
+
+	return changes
+}
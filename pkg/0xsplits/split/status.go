@@ -39,6 +39,38 @@ func (c *Client) GetController(ctx context.Context, node *execution.Node, contra
 	return &controller, nil
 }
 
+// GetNewPotentialController returns the controller a pending control transfer of the
+// split is to, the zero address if there is none.
+func (c *Client) GetNewPotentialController(ctx context.Context, node *execution.Node, contractABI *ethcoder.ABI) (*string, error) {
+	if c.splitAddress == nil {
+		return nil, fmt.Errorf("split address is required")
+	}
+
+	calldata, err := contractABI.EncodeMethodCalldataFromStringValues("getNewPotentialController", []string{*c.splitAddress})
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := node.ReadContract(ctx, c.contractAddress, calldata, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := contractABI.RawABI().Methods["getNewPotentialController"].Outputs.UnpackValues(result)
+	if err != nil {
+		return nil, err
+	}
+
+	potentialController, ok := values[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid potential controller address")
+	}
+
+	controller := potentialController.Hex()
+
+	return &controller, nil
+}
+
 func (c *Client) GetHash(ctx context.Context, node *execution.Node, contractABI *ethcoder.ABI) (*[32]uint8, error) {
 	if c.splitAddress == nil {
 		return nil, fmt.Errorf("split address is required")
@@ -0,0 +1,126 @@
+package execution
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/0xsequence/ethkit/ethcoder"
+	"github.com/0xsequence/ethkit/go-ethereum/common"
+)
+
+// erc20ABI is the subset of the ERC-20 interface read by splitoor.
+const erc20ABI = `[
+	{"inputs":[{"name":"account","type":"address"}],"name":"balanceOf","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"},
+	{"inputs":[],"name":"decimals","outputs":[{"name":"","type":"uint8"}],"stateMutability":"view","type":"function"},
+	{"inputs":[],"name":"symbol","outputs":[{"name":"","type":"string"}],"stateMutability":"view","type":"function"}
+]`
+
+var (
+	erc20     *ethcoder.ABI
+	erc20Err  error
+	erc20Once sync.Once
+)
+
+func getERC20ABI() (*ethcoder.ABI, error) {
+	erc20Once.Do(func() {
+		wrappedABI := ethcoder.NewABI()
+
+		erc20Err = wrappedABI.AddABIFromJSON(erc20ABI)
+		erc20 = &wrappedABI
+	})
+
+	return erc20, erc20Err
+}
+
+func (n *Node) callERC20(ctx context.Context, token, method string, args ...interface{}) ([]byte, error) {
+	contractABI, err := getERC20ABI()
+	if err != nil {
+		return nil, err
+	}
+
+	calldata, err := contractABI.EncodeMethodCalldata(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.ReadContract(ctx, token, calldata, nil)
+}
+
+func (n *Node) readERC20(ctx context.Context, token, method string, args ...interface{}) ([]interface{}, error) {
+	result, err := n.callERC20(ctx, token, method, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := erc20.RawABI().Methods[method].Outputs.UnpackValues(result)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(values) == 0 {
+		return nil, fmt.Errorf("empty %s result", method)
+	}
+
+	return values, nil
+}
+
+// ERC20BalanceOf returns the token balance of the account in the smallest unit of the token.
+func (n *Node) ERC20BalanceOf(ctx context.Context, token, account string) (*big.Int, error) {
+	values, err := n.readERC20(ctx, token, "balanceOf", common.HexToAddress(account))
+	if err != nil {
+		return nil, err
+	}
+
+	balance, ok := values[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("invalid balance")
+	}
+
+	return balance, nil
+}
+
+func (n *Node) ERC20Decimals(ctx context.Context, token string) (uint8, error) {
+	values, err := n.readERC20(ctx, token, "decimals")
+	if err != nil {
+		return 0, err
+	}
+
+	decimals, ok := values[0].(uint8)
+	if !ok {
+		return 0, fmt.Errorf("invalid decimals")
+	}
+
+	return decimals, nil
+}
+
+// ERC20Symbol returns the token symbol. Tokens that return the symbol as bytes32, eg. MKR,
+// are supported as well.
+func (n *Node) ERC20Symbol(ctx context.Context, token string) (string, error) {
+	result, err := n.callERC20(ctx, token, "symbol")
+	if err != nil {
+		return "", err
+	}
+
+	if len(result) == 32 {
+		return string(bytes.TrimRight(result, "\x00")), nil
+	}
+
+	values, err := erc20.RawABI().Methods["symbol"].Outputs.UnpackValues(result)
+	if err != nil {
+		return "", err
+	}
+
+	if len(values) == 0 {
+		return "", fmt.Errorf("empty symbol result")
+	}
+
+	symbol, ok := values[0].(string)
+	if !ok {
+		return "", fmt.Errorf("invalid symbol")
+	}
+
+	return symbol, nil
+}
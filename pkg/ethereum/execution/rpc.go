@@ -272,3 +272,29 @@ func (n *Node) WriteContract(ctx context.Context, contractAddress string, callDa
 
 	return &txHash, nil
 }
+
+func (n *Node) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	var logs []types.Log
+
+	_, err := n.rpc.Do(ctx, ethrpc.FilterLogs(query).Into(&logs))
+	if err != nil {
+		return nil, err
+	}
+
+	return logs, nil
+}
+
+// TransactionSender recovers the address that signed the transaction.
+func (n *Node) TransactionSender(ctx context.Context, tx *types.Transaction) (string, error) {
+	chainID, err := n.ChainID(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	sender, err := types.Sender(types.LatestSignerForChainID(chainID), tx)
+	if err != nil {
+		return "", err
+	}
+
+	return sender.Hex(), nil
+}
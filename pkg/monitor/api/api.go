@@ -0,0 +1,250 @@
+package api
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/journal"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/silence"
+	"github.com/ethpandaops/splitoor/pkg/monitor/status"
+	"github.com/sirupsen/logrus"
+)
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// Handler serves the status API, silences are the only resource that can be modified and
+// only if a token is configured.
+type Handler struct {
+	log        logrus.FieldLogger
+	splits     []status.SplitProvider
+	validators []status.ValidatorProvider
+	silences   *silence.Manager
+	journal    *journal.Journal
+	token      string
+
+	mux *http.ServeMux
+}
+
+func NewHandler(log logrus.FieldLogger, splits []status.SplitProvider, validators []status.ValidatorProvider, silences *silence.Manager, events *journal.Journal, token string) *Handler {
+	h := &Handler{
+		log:        log.WithField("component", "api"),
+		splits:     splits,
+		validators: validators,
+		silences:   silences,
+		journal:    events,
+		token:      token,
+		mux:        http.NewServeMux(),
+	}
+
+	h.mux.HandleFunc("GET /api/v1/splits", h.handleSplits)
+	h.mux.HandleFunc("GET /api/v1/splits/{group}", h.handleSplit)
+	h.mux.HandleFunc("GET /api/v1/validators", h.handleValidators)
+	h.mux.HandleFunc("GET /api/v1/validators/{group}", h.handleValidator)
+	h.mux.HandleFunc("GET /api/v1/alerts", h.handleAlerts)
+
+	if silences != nil {
+		h.mux.HandleFunc("GET /api/v1/silences", h.handleSilences)
+
+		// anyone who can modify silences can mute every alert
+		if token != "" {
+			h.mux.HandleFunc("POST /api/v1/silences", h.authorize(h.handleAddSilence))
+			h.mux.HandleFunc("DELETE /api/v1/silences/{id}", h.authorize(h.handleDeleteSilence))
+		}
+	}
+
+	if events != nil {
+		h.mux.HandleFunc("GET /api/v1/events", h.handleEvents)
+	}
+
+	return h
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+// authorize only calls next if the request has the configured bearer token.
+func (h *Handler) authorize(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(h.token)) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			h.writeJSON(w, http.StatusUnauthorized, &errorResponse{Error: "unauthorized"})
+
+			return
+		}
+
+		next(w, r)
+	}
+}
+
+func (h *Handler) splitGroups() []*status.SplitGroup {
+	groups := []*status.SplitGroup{}
+
+	for _, p := range h.splits {
+		groups = append(groups, p.SplitGroups()...)
+	}
+
+	return groups
+}
+
+func (h *Handler) validatorGroups() []*status.ValidatorGroup {
+	groups := []*status.ValidatorGroup{}
+
+	for _, p := range h.validators {
+		groups = append(groups, p.ValidatorGroups()...)
+	}
+
+	return groups
+}
+
+func (h *Handler) handleSplits(w http.ResponseWriter, r *http.Request) {
+	h.writeJSON(w, http.StatusOK, h.splitGroups())
+}
+
+func (h *Handler) handleSplit(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("group")
+
+	for _, g := range h.splitGroups() {
+		if g.Name == name {
+			h.writeJSON(w, http.StatusOK, g)
+
+			return
+		}
+	}
+
+	h.writeJSON(w, http.StatusNotFound, &errorResponse{Error: "split group not found"})
+}
+
+func (h *Handler) handleValidators(w http.ResponseWriter, r *http.Request) {
+	h.writeJSON(w, http.StatusOK, h.validatorGroups())
+}
+
+func (h *Handler) handleValidator(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("group")
+
+	for _, g := range h.validatorGroups() {
+		if g.Name == name {
+			h.writeJSON(w, http.StatusOK, g)
+
+			return
+		}
+	}
+
+	h.writeJSON(w, http.StatusNotFound, &errorResponse{Error: "validator group not found"})
+}
+
+// handleAlerts returns the alerts that are currently firing, or every alert if ?all=true.
+func (h *Handler) handleAlerts(w http.ResponseWriter, r *http.Request) {
+	all := r.URL.Query().Get("all") == "true"
+
+	alerts := []*status.Alert{}
+
+	for _, g := range h.splitGroups() {
+		alerts = appendAlerts(alerts, g.Alerts, all)
+	}
+
+	for _, g := range h.validatorGroups() {
+		alerts = appendAlerts(alerts, g.Alerts, all)
+	}
+
+	h.writeJSON(w, http.StatusOK, alerts)
+}
+
+func appendAlerts(alerts, add []*status.Alert, all bool) []*status.Alert {
+	for _, a := range add {
+		if all || a.Alerting {
+			alerts = append(alerts, a)
+		}
+	}
+
+	return alerts
+}
+
+// handleSilences returns the pending and active silences, or every silence if ?all=true.
+func (h *Handler) handleSilences(w http.ResponseWriter, r *http.Request) {
+	all := r.URL.Query().Get("all") == "true"
+
+	h.writeJSON(w, http.StatusOK, h.silences.List(time.Now(), all))
+}
+
+func (h *Handler) handleAddSilence(w http.ResponseWriter, r *http.Request) {
+	var s silence.Silence
+	if err := json.NewDecoder(r.Body).Decode(&s); err != nil {
+		h.writeJSON(w, http.StatusBadRequest, &errorResponse{Error: "invalid silence: " + err.Error()})
+
+		return
+	}
+
+	created, err := h.silences.Add(r.Context(), s)
+	if err != nil {
+		h.writeJSON(w, http.StatusBadRequest, &errorResponse{Error: err.Error()})
+
+		return
+	}
+
+	h.writeJSON(w, http.StatusCreated, created)
+}
+
+func (h *Handler) handleDeleteSilence(w http.ResponseWriter, r *http.Request) {
+	err := h.silences.Delete(r.Context(), r.PathValue("id"))
+
+	switch {
+	case err == nil:
+		w.WriteHeader(http.StatusNoContent)
+	case errors.Is(err, silence.ErrNotFound):
+		h.writeJSON(w, http.StatusNotFound, &errorResponse{Error: err.Error()})
+	case errors.Is(err, silence.ErrStatic):
+		h.writeJSON(w, http.StatusConflict, &errorResponse{Error: err.Error()})
+	default:
+		h.log.WithError(err).Error("Error deleting silence")
+
+		h.writeJSON(w, http.StatusInternalServerError, &errorResponse{Error: "error deleting silence"})
+	}
+}
+
+// handleEvents returns the journal entries newest first, filtered by the optional group,
+// type, since (RFC3339 or a duration, eg. 24h) and limit query parameters.
+func (h *Handler) handleEvents(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+
+	since, err := journal.ParseSince(params.Get("since"), time.Now())
+	if err != nil {
+		h.writeJSON(w, http.StatusBadRequest, &errorResponse{Error: err.Error()})
+
+		return
+	}
+
+	q := journal.Query{
+		Group: params.Get("group"),
+		Type:  params.Get("type"),
+		Since: since,
+	}
+
+	if limit := params.Get("limit"); limit != "" {
+		q.Limit, err = strconv.Atoi(limit)
+		if err != nil || q.Limit < 0 {
+			h.writeJSON(w, http.StatusBadRequest, &errorResponse{Error: "invalid limit"})
+
+			return
+		}
+	}
+
+	h.writeJSON(w, http.StatusOK, h.journal.List(q))
+}
+
+func (h *Handler) writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		h.log.WithError(err).Error("Error encoding response")
+	}
+}
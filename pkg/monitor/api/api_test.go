@@ -0,0 +1,347 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/api"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/split"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/journal"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/silence"
+	"github.com/ethpandaops/splitoor/pkg/monitor/status"
+	"github.com/ethpandaops/splitoor/pkg/monitor/store"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSplits struct {
+	groups []*status.SplitGroup
+}
+
+func (m *mockSplits) SplitGroups() []*status.SplitGroup {
+	return m.groups
+}
+
+type mockValidators struct {
+	groups []*status.ValidatorGroup
+}
+
+func (m *mockValidators) ValidatorGroups() []*status.ValidatorGroup {
+	return m.groups
+}
+
+func newHandler() *api.Handler {
+	since := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	splits := &mockSplits{
+		groups: []*status.SplitGroup{
+			{
+				Name:    "split-1",
+				Address: "0x123",
+				Controller: &status.Controller{
+					Type:    "safe",
+					Address: "0x456",
+					Safe: &status.Safe{
+						Address:   "0x456",
+						QueueSize: 1,
+					},
+				},
+				StableHash: "abc",
+				Sources: map[string]*status.SplitSource{
+					"node-1": {Hash: "abc", HashState: "stable", Controller: "0x456", Balance: "1000"},
+				},
+				Alerts: []*status.Alert{
+					status.NewAlert("monitor", "split-1", "split_controller", "0x123", false, time.Time{}),
+					status.NewAlert("monitor", "split-1", "split_hash_unknown_state", "0x123", true, since),
+				},
+			},
+		},
+	}
+
+	validators := &mockValidators{
+		groups: []*status.ValidatorGroup{
+			{
+				Name: "validators-1",
+				Validators: map[string]map[string]*status.ValidatorSource{
+					"0xabc": {
+						"beacon-1": {Balance: 32000000000, Status: "active_online", WithdrawalCredentialsCode: 1},
+					},
+				},
+				Alerts: []*status.Alert{
+					status.NewAlert("monitor", "validators-1", "validator_status", "0xabc", false, since),
+				},
+			},
+		},
+	}
+
+	return api.NewHandler(logrus.New(), []status.SplitProvider{splits}, []status.ValidatorProvider{validators}, nil, nil, "")
+}
+
+func TestHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		path       string
+		wantStatus int
+		check      func(t *testing.T, body []byte)
+	}{
+		{
+			name:       "list splits",
+			path:       "/api/v1/splits",
+			wantStatus: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				t.Helper()
+
+				var groups []*status.SplitGroup
+				require.NoError(t, json.Unmarshal(body, &groups))
+				require.Len(t, groups, 1)
+				assert.Equal(t, "split-1", groups[0].Name)
+				assert.Equal(t, "safe", groups[0].Controller.Type)
+				assert.Equal(t, 1, groups[0].Controller.Safe.QueueSize)
+				assert.Equal(t, "stable", groups[0].Sources["node-1"].HashState)
+			},
+		},
+		{
+			name:       "get split",
+			path:       "/api/v1/splits/split-1",
+			wantStatus: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				t.Helper()
+
+				var group status.SplitGroup
+				require.NoError(t, json.Unmarshal(body, &group))
+				assert.Equal(t, "0x123", group.Address)
+				assert.Equal(t, "1000", group.Sources["node-1"].Balance)
+			},
+		},
+		{
+			name:       "unknown split",
+			path:       "/api/v1/splits/unknown",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "get validators",
+			path:       "/api/v1/validators/validators-1",
+			wantStatus: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				t.Helper()
+
+				var group status.ValidatorGroup
+				require.NoError(t, json.Unmarshal(body, &group))
+				assert.Equal(t, "active_online", group.Validators["0xabc"]["beacon-1"].Status)
+			},
+		},
+		{
+			name:       "unknown validators",
+			path:       "/api/v1/validators/unknown",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "firing alerts",
+			path:       "/api/v1/alerts",
+			wantStatus: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				t.Helper()
+
+				var alerts []*status.Alert
+				require.NoError(t, json.Unmarshal(body, &alerts))
+				require.Len(t, alerts, 1)
+				assert.Equal(t, "split_hash_unknown_state", alerts[0].Type)
+				assert.NotNil(t, alerts[0].Since)
+			},
+		},
+		{
+			name:       "all alerts",
+			path:       "/api/v1/alerts?all=true",
+			wantStatus: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				t.Helper()
+
+				var alerts []*status.Alert
+				require.NoError(t, json.Unmarshal(body, &alerts))
+				assert.Len(t, alerts, 3)
+				assert.Nil(t, alerts[0].Since)
+			},
+		},
+	}
+
+	handler := newHandler()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, http.NoBody)
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+			if tt.check != nil {
+				tt.check(t, rec.Body.Bytes())
+			}
+		})
+	}
+}
+
+func TestHandler_MethodNotAllowed(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/splits", http.NoBody)
+	rec := httptest.NewRecorder()
+
+	newHandler().ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestHandler_Silences(t *testing.T) {
+	ctx := context.Background()
+
+	st, err := store.NewStore(ctx, logrus.New(), nil)
+	require.NoError(t, err)
+
+	silences, err := silence.NewManager(logrus.New(), "monitor", []silence.Silence{
+		{Group: "split-1", End: time.Now().Add(time.Hour)},
+	}, st)
+	require.NoError(t, err)
+
+	handler := api.NewHandler(logrus.New(), nil, nil, silences, nil, "secret")
+
+	do := func(method, path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer secret")
+
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	rec := do(http.MethodPost, "/api/v1/silences", `{"group":"split-2","eventType":"split_hash_*","end":"2100-01-01T00:00:00Z","comment":"maintenance"}`)
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var created silence.Silence
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	assert.NotEmpty(t, created.ID)
+	assert.Equal(t, "split_hash_*", created.EventType)
+	assert.False(t, created.Static)
+
+	rec = do(http.MethodPost, "/api/v1/silences", `{"group":"split-2"}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = do(http.MethodGet, "/api/v1/silences", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var list []*silence.Silence
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+	assert.Len(t, list, 2)
+
+	assert.Equal(t, http.StatusConflict, do(http.MethodDelete, "/api/v1/silences/config-0", "").Code)
+	assert.Equal(t, http.StatusNotFound, do(http.MethodDelete, "/api/v1/silences/unknown", "").Code)
+	assert.Equal(t, http.StatusNoContent, do(http.MethodDelete, "/api/v1/silences/"+created.ID, "").Code)
+	assert.Len(t, silences.List(time.Now(), false), 1)
+}
+
+func TestHandler_SilencesUnauthorized(t *testing.T) {
+	silences, err := silence.NewManager(logrus.New(), "monitor", nil, nil)
+	require.NoError(t, err)
+
+	body := `{"group":"split-1","end":"2100-01-01T00:00:00Z"}`
+
+	// without a token silences can only be listed
+	readOnly := api.NewHandler(logrus.New(), nil, nil, silences, nil, "")
+
+	rec := httptest.NewRecorder()
+	readOnly.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/silences", http.NoBody))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = httptest.NewRecorder()
+	readOnly.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/v1/silences", strings.NewReader(body)))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+
+	handler := api.NewHandler(logrus.New(), nil, nil, silences, nil, "secret")
+
+	for _, header := range []string{"", "Bearer wrong", "secret"} {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/silences", strings.NewReader(body))
+		if header != "" {
+			req.Header.Set("Authorization", header)
+		}
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code, header)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/api/v1/silences/config-0", http.NoBody))
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	assert.Empty(t, silences.List(time.Now(), true))
+}
+
+func TestHandler_SilencesDisabled(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/silences", http.NoBody)
+	rec := httptest.NewRecorder()
+
+	newHandler().ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestHandler_Events(t *testing.T) {
+	events := journal.New(logrus.New(), &journal.Config{MaxEntries: 10})
+	now := time.Now()
+
+	_, err := events.Record(split.NewHashRecoveryState(now, "monitor", "split-1", "0x123", "0xabc"), false, now.Add(-2*time.Hour))
+	require.NoError(t, err)
+	_, err = events.Record(split.NewController(now, "monitor", "split-2", "0x456", "0x1", "0x2"), false, now)
+	require.NoError(t, err)
+
+	handler := api.NewHandler(logrus.New(), nil, nil, nil, events, "")
+
+	tests := []struct {
+		name     string
+		query    string
+		wantCode int
+		wantIDs  []uint64
+	}{
+		{name: "all", query: "", wantCode: http.StatusOK, wantIDs: []uint64{2, 1}},
+		{name: "group", query: "?group=split-1", wantCode: http.StatusOK, wantIDs: []uint64{1}},
+		{name: "type", query: "?type=split_controller", wantCode: http.StatusOK, wantIDs: []uint64{2}},
+		{name: "since", query: "?since=1h", wantCode: http.StatusOK, wantIDs: []uint64{2}},
+		{name: "limit", query: "?limit=1", wantCode: http.StatusOK, wantIDs: []uint64{2}},
+		{name: "invalid since", query: "?since=yesterday", wantCode: http.StatusBadRequest},
+		{name: "invalid limit", query: "?limit=-1", wantCode: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/events"+tt.query, http.NoBody)
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantCode, rec.Code)
+
+			if tt.wantCode != http.StatusOK {
+				return
+			}
+
+			var entries []*journal.Entry
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &entries))
+
+			ids := []uint64{}
+			for _, e := range entries {
+				ids = append(ids, e.ID)
+			}
+
+			assert.Equal(t, tt.wantIDs, ids)
+		})
+	}
+}
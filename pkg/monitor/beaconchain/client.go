@@ -3,6 +3,8 @@ package beaconchain
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"sync"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -20,6 +22,8 @@ type Client interface {
 	GetMaxRequestsPerMinute() int
 	// GetCheckInterval returns the check interval
 	GetCheckInterval() time.Duration
+	// Reachable returns whether the last request reached the API and when it was made
+	Reachable() (reachable bool, checkedAt time.Time)
 }
 
 type client struct {
@@ -30,6 +34,10 @@ type client struct {
 	maxRequestsPerMinute int
 	checkInterval        time.Duration
 	metrics              *Metrics
+
+	reachable bool
+	checkedAt time.Time
+	mu        sync.Mutex
 }
 
 // NewClient creates a new beaconchain instance
@@ -93,3 +101,18 @@ func (c *client) GetMaxRequestsPerMinute() int {
 func (c *client) GetCheckInterval() time.Duration {
 	return c.checkInterval
 }
+
+func (c *client) Reachable() (reachable bool, checkedAt time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.reachable, c.checkedAt
+}
+
+func (c *client) setReachable(resp *http.Response, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.reachable = err == nil && resp.StatusCode < http.StatusInternalServerError
+	c.checkedAt = time.Now()
+}
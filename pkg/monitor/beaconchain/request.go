@@ -42,6 +42,7 @@ func (c *client) get(ctx context.Context, path, url string) ([]byte, error) {
 
 	client := &http.Client{}
 	resp, err := client.Do(req)
+	c.setReachable(resp, err)
 
 	if err != nil {
 		return nil, err
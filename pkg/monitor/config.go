@@ -5,9 +5,12 @@ import (
 
 	"github.com/ethpandaops/splitoor/pkg/ethereum"
 	"github.com/ethpandaops/splitoor/pkg/monitor/beaconchain"
+	"github.com/ethpandaops/splitoor/pkg/monitor/digest"
+	"github.com/ethpandaops/splitoor/pkg/monitor/health"
 	"github.com/ethpandaops/splitoor/pkg/monitor/notifier"
 	"github.com/ethpandaops/splitoor/pkg/monitor/safe"
 	"github.com/ethpandaops/splitoor/pkg/monitor/service"
+	"github.com/ethpandaops/splitoor/pkg/monitor/store"
 )
 
 type Config struct {
@@ -15,6 +18,12 @@ type Config struct {
 	MetricsAddr string `yaml:"metricsAddr" default:":9090"`
 	// HealthCheckAddr is the address to listen on for healthcheck.
 	HealthCheckAddr *string `yaml:"healthCheckAddr"`
+	// APIAddr is the address to listen on for the status API. The API is read-only unless
+	// APIToken is set.
+	APIAddr *string `yaml:"apiAddr"`
+	// APIToken enables the API endpoints that modify the monitor, eg. adding silences. The
+	// requests must send it as a bearer token.
+	APIToken *string `yaml:"apiToken"`
 	// PProfAddr is the address to listen on for pprof.
 	PProfAddr *string `yaml:"pprofAddr"`
 	// LoggingLevel is the logging level to use.
@@ -31,6 +40,12 @@ type Config struct {
 	Beaconchain beaconchain.Config `yaml:"beaconchain"`
 	// Safe is the safe configuration.
 	Safe safe.Config `yaml:"safe"`
+	// Health configures the healthcheck server probes.
+	Health health.Config `yaml:"health"`
+	// Store is the state store used to persist alert state across restarts.
+	Store store.Config `yaml:"store"`
+	// Digests are scheduled summaries sent through the notifier sources.
+	Digests []digest.Config `yaml:"digests"`
 }
 
 func (c *Config) Validate() error {
@@ -54,5 +69,28 @@ func (c *Config) Validate() error {
 		return err
 	}
 
+	if err := c.Health.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.Store.Validate(); err != nil {
+		return err
+	}
+
+	names := map[string]bool{}
+
+	for i := range c.Digests {
+		if err := c.Digests[i].Validate(); err != nil {
+			return err
+		}
+
+		name := c.Digests[i].GetName()
+		if names[name] {
+			return fmt.Errorf("digest name %s is not unique", name)
+		}
+
+		names[name] = true
+	}
+
 	return nil
 }
@@ -0,0 +1,104 @@
+package digest
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/creasty/defaults"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+)
+
+type Config struct {
+	// Name identifies the digest, defaults to the period.
+	Name string `yaml:"name"`
+	// Period is how often the digest is sent, daily or weekly.
+	Period string `yaml:"period" default:"daily"`
+	// At is the UTC time of day the digest is sent at, eg. 09:00.
+	At string `yaml:"at" default:"09:00"`
+	// Weekday is the day weekly digests are sent on.
+	Weekday string `yaml:"weekday" default:"monday"`
+	// Sources are the names of the notifier sources the digest is sent to.
+	Sources []string `yaml:"sources"`
+}
+
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// UnmarshalYAML applies the defaults before unmarshalling, as digests are configured
+// as a list and the defaults of the monitor config don't reach list elements.
+func (c *Config) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if err := defaults.Set(c); err != nil {
+		return err
+	}
+
+	type plain Config
+
+	return unmarshal((*plain)(c))
+}
+
+func (c *Config) Validate() error {
+	if c.Period != event.DigestPeriodDaily && c.Period != event.DigestPeriodWeekly {
+		return fmt.Errorf("digest period must be %s or %s", event.DigestPeriodDaily, event.DigestPeriodWeekly)
+	}
+
+	if _, err := time.Parse("15:04", c.At); err != nil {
+		return fmt.Errorf("digest at must be a time of day, eg. 09:00: %w", err)
+	}
+
+	if _, ok := weekdays[strings.ToLower(c.Weekday)]; !ok {
+		return fmt.Errorf("digest weekday %s is invalid", c.Weekday)
+	}
+
+	if len(c.Sources) == 0 {
+		return errors.New("digest sources are required")
+	}
+
+	return nil
+}
+
+// GetName returns the name of the digest, defaults to the period.
+func (c *Config) GetName() string {
+	if c.Name != "" {
+		return c.Name
+	}
+
+	return c.Period
+}
+
+// NextRun returns the first time after now the digest should be sent.
+func (c *Config) NextRun(now time.Time) time.Time {
+	now = now.UTC()
+
+	at, err := time.Parse("15:04", c.At)
+	if err != nil {
+		return time.Time{}
+	}
+
+	next := time.Date(now.Year(), now.Month(), now.Day(), at.Hour(), at.Minute(), 0, 0, time.UTC)
+
+	if c.Period == event.DigestPeriodWeekly {
+		days := (int(weekdays[strings.ToLower(c.Weekday)]) - int(now.Weekday()) + 7) % 7
+		next = next.AddDate(0, 0, days)
+
+		if !next.After(now) {
+			next = next.AddDate(0, 0, 7)
+		}
+
+		return next
+	}
+
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 1)
+	}
+
+	return next
+}
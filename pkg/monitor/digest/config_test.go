@@ -0,0 +1,139 @@
+package digest_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/creasty/defaults"
+	"github.com/ethpandaops/splitoor/pkg/monitor/digest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      *digest.Config
+		expectError bool
+	}{
+		{
+			name:   "valid daily",
+			config: &digest.Config{Period: "daily", At: "09:00", Weekday: "monday", Sources: []string{"email"}},
+		},
+		{
+			name:   "valid weekly",
+			config: &digest.Config{Period: "weekly", At: "17:30", Weekday: "Friday", Sources: []string{"email"}},
+		},
+		{
+			name:        "invalid period",
+			config:      &digest.Config{Period: "hourly", At: "09:00", Weekday: "monday", Sources: []string{"email"}},
+			expectError: true,
+		},
+		{
+			name:        "invalid at",
+			config:      &digest.Config{Period: "daily", At: "9am", Weekday: "monday", Sources: []string{"email"}},
+			expectError: true,
+		},
+		{
+			name:        "invalid weekday",
+			config:      &digest.Config{Period: "weekly", At: "09:00", Weekday: "someday", Sources: []string{"email"}},
+			expectError: true,
+		},
+		{
+			name:        "missing sources",
+			config:      &digest.Config{Period: "daily", At: "09:00", Weekday: "monday"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestConfigDefaults(t *testing.T) {
+	config := &digest.Config{Sources: []string{"email"}}
+	require.NoError(t, defaults.Set(config))
+	require.NoError(t, config.Validate())
+
+	assert.Equal(t, "daily", config.GetName())
+	assert.Equal(t, "09:00", config.At)
+}
+
+func TestConfigUnmarshalYAML(t *testing.T) {
+	var configs []*digest.Config
+	require.NoError(t, yaml.Unmarshal([]byte(`
+- sources: ["email"]
+- period: weekly
+  weekday: friday
+  sources: ["discord"]
+`), &configs))
+	require.Len(t, configs, 2)
+
+	assert.Equal(t, "daily", configs[0].Period)
+	assert.Equal(t, "09:00", configs[0].At)
+	assert.Equal(t, "weekly", configs[1].GetName())
+	assert.Equal(t, "friday", configs[1].Weekday)
+	assert.NoError(t, configs[1].Validate())
+}
+
+func TestConfigNextRun(t *testing.T) {
+	// 2024-01-03 is a wednesday
+	now := time.Date(2024, 1, 3, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		config *digest.Config
+		want   time.Time
+	}{
+		{
+			name:   "daily later today",
+			config: &digest.Config{Period: "daily", At: "12:30"},
+			want:   time.Date(2024, 1, 3, 12, 30, 0, 0, time.UTC),
+		},
+		{
+			name:   "daily tomorrow",
+			config: &digest.Config{Period: "daily", At: "09:00"},
+			want:   time.Date(2024, 1, 4, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "daily exactly now",
+			config: &digest.Config{Period: "daily", At: "10:00"},
+			want:   time.Date(2024, 1, 4, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "weekly later this week",
+			config: &digest.Config{Period: "weekly", At: "09:00", Weekday: "friday"},
+			want:   time.Date(2024, 1, 5, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "weekly next week",
+			config: &digest.Config{Period: "weekly", At: "09:00", Weekday: "monday"},
+			want:   time.Date(2024, 1, 8, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "weekly today already sent",
+			config: &digest.Config{Period: "weekly", At: "09:00", Weekday: "wednesday"},
+			want:   time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "weekly later today",
+			config: &digest.Config{Period: "weekly", At: "11:00", Weekday: "wednesday"},
+			want:   time.Date(2024, 1, 3, 11, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.config.NextRun(now))
+		})
+	}
+}
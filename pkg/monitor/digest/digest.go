@@ -0,0 +1,281 @@
+package digest
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/ethpandaops/splitoor/pkg/monitor/status"
+	"github.com/ethpandaops/splitoor/pkg/monitor/store"
+	"github.com/sirupsen/logrus"
+)
+
+// Publisher sends a digest to the named notifier sources.
+type Publisher interface {
+	PublishTo(e event.Event, names []string) error
+}
+
+// snapshot is persisted after every digest so the next one can report the period and
+// the balance change of the validator groups.
+type snapshot struct {
+	Timestamp time.Time         `json:"timestamp"`
+	Balances  map[string]uint64 `json:"balances"`
+}
+
+// Digest periodically sends a summary of every split and validator group to the
+// configured notifier sources.
+type Digest struct {
+	log        logrus.FieldLogger
+	monitor    string
+	config     *Config
+	publisher  Publisher
+	splits     []status.SplitProvider
+	validators []status.ValidatorProvider
+	store      store.Store
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewDigest(log logrus.FieldLogger, monitor string, config *Config, publisher Publisher, splits []status.SplitProvider, validators []status.ValidatorProvider, st store.Store) *Digest {
+	return &Digest{
+		log:        log.WithField("component", "digest").WithField("digest", config.GetName()),
+		monitor:    monitor,
+		config:     config,
+		publisher:  publisher,
+		splits:     splits,
+		validators: validators,
+		store:      st,
+	}
+}
+
+func (d *Digest) Start(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	d.cancel = cancel
+
+	d.wg.Add(1)
+
+	go func() {
+		defer d.wg.Done()
+
+		d.run(ctx)
+	}()
+
+	return nil
+}
+
+func (d *Digest) Stop(ctx context.Context) error {
+	if d.cancel != nil {
+		d.cancel()
+	}
+
+	d.wg.Wait()
+
+	return nil
+}
+
+func (d *Digest) run(ctx context.Context) {
+	for {
+		next := d.config.NextRun(time.Now())
+
+		d.log.WithField("next", next).Debug("Scheduled digest")
+
+		timer := time.NewTimer(time.Until(next))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return
+		case <-timer.C:
+			if err := d.Send(ctx, time.Now()); err != nil {
+				d.log.WithError(err).Error("Error sending digest")
+			}
+		}
+	}
+}
+
+// Send builds the digest, publishes it and stores the snapshot for the next digest.
+func (d *Digest) Send(ctx context.Context, now time.Time) error {
+	previous, err := d.loadSnapshot(ctx)
+	if err != nil {
+		d.log.WithError(err).Warn("Error loading previous digest, balance changes will be unknown")
+	}
+
+	digest := d.Build(now, previous)
+
+	if err := d.publisher.PublishTo(digest, d.config.Sources); err != nil {
+		return err
+	}
+
+	d.log.Info("Sent digest")
+
+	next := &snapshot{
+		Timestamp: now,
+		Balances:  make(map[string]uint64, len(digest.Validators)),
+	}
+
+	for _, g := range digest.Validators {
+		next.Balances[g.Group] = g.TotalBalance
+	}
+
+	return d.saveSnapshot(ctx, next)
+}
+
+// Build creates the digest from the current status of the split and validator groups.
+func (d *Digest) Build(now time.Time, previous *snapshot) *event.Digest {
+	var from time.Time
+	if previous != nil {
+		from = previous.Timestamp
+	}
+
+	splits := []*event.DigestSplit{}
+
+	for _, p := range d.splits {
+		for _, g := range p.SplitGroups() {
+			splits = append(splits, newDigestSplit(g))
+		}
+	}
+
+	validators := []*event.DigestValidatorGroup{}
+
+	for _, p := range d.validators {
+		for _, g := range p.ValidatorGroups() {
+			v := newDigestValidatorGroup(g)
+
+			if previous != nil {
+				if balance, ok := previous.Balances[g.Name]; ok {
+					v.PreviousBalance = &balance
+				}
+			}
+
+			validators = append(validators, v)
+		}
+	}
+
+	return event.NewDigest(now, from, d.config.Period, d.monitor, splits, validators)
+}
+
+func newDigestSplit(g *status.SplitGroup) *event.DigestSplit {
+	s := &event.DigestSplit{
+		Group:     g.Name,
+		Address:   g.Address,
+		HashState: "unknown",
+		Accounts:  []*event.DigestAccount{},
+	}
+
+	if g.Controller != nil {
+		s.ControllerType = g.Controller.Type
+		s.ExpectedController = g.Controller.Address
+
+		if g.Controller.Safe != nil {
+			s.Safe = &event.DigestSafe{
+				QueueSize:                g.Controller.Safe.QueueSize,
+				RecoveryTransaction:      g.Controller.Safe.RecoveryTransaction,
+				RecoveryTransactionValid: g.Controller.Safe.RecoveryTransactionValid,
+				RecoveryTransactionNext:  g.Controller.Safe.RecoveryTransactionNext,
+				Confirmations:            g.Controller.Safe.Confirmations,
+				ExpectedConfirmations:    g.Controller.Safe.ExpectedConfirmations,
+			}
+		}
+	}
+
+	// the state is reported as seen by the first execution node
+	if nodes := sortedKeys(g.Sources); len(nodes) > 0 {
+		source := g.Sources[nodes[0]]
+
+		s.Controller = source.Controller
+		s.HashState = source.HashState
+		s.Balance = source.Balance
+	}
+
+	for _, a := range g.Accounts {
+		account := &event.DigestAccount{Address: a.Address}
+
+		if nodes := sortedKeys(a.Sources); len(nodes) > 0 {
+			account.SplitBalance = a.Sources[nodes[0]].SplitBalance
+		}
+
+		s.Accounts = append(s.Accounts, account)
+	}
+
+	return s
+}
+
+func newDigestValidatorGroup(g *status.ValidatorGroup) *event.DigestValidatorGroup {
+	v := &event.DigestValidatorGroup{
+		Group:      g.Name,
+		Validators: len(g.Validators),
+		Statuses:   map[string]int{},
+	}
+
+	for _, sources := range g.Validators {
+		nodes := sortedKeys(sources)
+		if len(nodes) == 0 {
+			v.Statuses["unknown"]++
+
+			continue
+		}
+
+		source := sources[nodes[0]]
+
+		v.Statuses[source.Status]++
+		v.TotalBalance += source.Balance
+	}
+
+	return v
+}
+
+func (d *Digest) storeKey() string {
+	return "digest/" + d.monitor + "/" + d.config.GetName()
+}
+
+func (d *Digest) loadSnapshot(ctx context.Context) (*snapshot, error) {
+	if d.store == nil {
+		return nil, nil
+	}
+
+	data, err := d.store.Get(ctx, d.storeKey())
+	if err != nil {
+		return nil, err
+	}
+
+	if data == nil {
+		return nil, nil
+	}
+
+	var s snapshot
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+func (d *Digest) saveSnapshot(ctx context.Context, s *snapshot) error {
+	if d.store == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	return d.store.Set(ctx, d.storeKey(), data)
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,128 @@
+package digest_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/digest"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/ethpandaops/splitoor/pkg/monitor/status"
+	"github.com/ethpandaops/splitoor/pkg/monitor/store"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockPublisher struct {
+	events  []event.Event
+	sources []string
+}
+
+func (m *mockPublisher) PublishTo(e event.Event, names []string) error {
+	m.events = append(m.events, e)
+	m.sources = names
+
+	return nil
+}
+
+type mockSplits struct {
+	groups []*status.SplitGroup
+}
+
+func (m *mockSplits) SplitGroups() []*status.SplitGroup {
+	return m.groups
+}
+
+type mockValidators struct {
+	groups []*status.ValidatorGroup
+}
+
+func (m *mockValidators) ValidatorGroups() []*status.ValidatorGroup {
+	return m.groups
+}
+
+func TestDigestSend(t *testing.T) {
+	ctx := context.Background()
+
+	st, err := store.NewStore(ctx, logrus.New(), nil)
+	require.NoError(t, err)
+
+	splits := &mockSplits{
+		groups: []*status.SplitGroup{
+			{
+				Name:    "split-1",
+				Address: "0x123",
+				Controller: &status.Controller{
+					Type:    "safe",
+					Address: "0x456",
+					Safe:    &status.Safe{QueueSize: 1, RecoveryTransaction: "tx", RecoveryTransactionValid: true, RecoveryTransactionNext: true, Confirmations: 1, ExpectedConfirmations: 1},
+				},
+				Sources: map[string]*status.SplitSource{
+					"node-2": {Controller: "0x999", HashState: "unknown", Balance: "0"},
+					"node-1": {Controller: "0x456", HashState: "stable", Balance: "1500000000000000000"},
+				},
+				Accounts: []*status.SplitAccount{
+					{Address: "0xaaa", Sources: map[string]*status.AccountSource{"node-1": {Balance: "1", SplitBalance: "250000000000000000"}}},
+				},
+			},
+		},
+	}
+
+	validators := &mockValidators{
+		groups: []*status.ValidatorGroup{
+			{
+				Name: "validators-1",
+				Validators: map[string]map[string]*status.ValidatorSource{
+					"0x1": {"beacon-1": {Balance: 32000000000, Status: "active_online"}},
+					"0x2": {"beacon-1": {Balance: 31000000000, Status: "active_online"}},
+					"0x3": {"beacon-1": {Balance: 0, Status: "exited_unslashed"}},
+				},
+			},
+		},
+	}
+
+	publisher := &mockPublisher{}
+	config := &digest.Config{Period: "daily", At: "09:00", Sources: []string{"email"}}
+
+	d := digest.NewDigest(logrus.New(), "monitor", config, publisher, []status.SplitProvider{splits}, []status.ValidatorProvider{validators}, st)
+
+	first := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	require.NoError(t, d.Send(ctx, first))
+	require.Len(t, publisher.events, 1)
+	assert.Equal(t, []string{"email"}, publisher.sources)
+
+	report, ok := publisher.events[0].(*event.Digest)
+	require.True(t, ok)
+	assert.True(t, report.From.IsZero())
+	assert.Equal(t, "daily", report.Period)
+
+	require.Len(t, report.Splits, 1)
+	assert.Equal(t, "0x456", report.Splits[0].Controller)
+	assert.Equal(t, "stable", report.Splits[0].HashState)
+	assert.Equal(t, "1500000000000000000", report.Splits[0].Balance)
+	assert.Equal(t, "250000000000000000", report.Splits[0].Accounts[0].SplitBalance)
+	assert.Equal(t, 1, report.Splits[0].Safe.QueueSize)
+
+	require.Len(t, report.Validators, 1)
+	assert.Equal(t, 3, report.Validators[0].Validators)
+	assert.Equal(t, map[string]int{"active_online": 2, "exited_unslashed": 1}, report.Validators[0].Statuses)
+	assert.Equal(t, uint64(63000000000), report.Validators[0].TotalBalance)
+
+	_, known := report.Validators[0].GetBalanceChange()
+	assert.False(t, known)
+
+	// the next digest reports the period and balance change since the previous one
+	validators.groups[0].Validators["0x1"]["beacon-1"].Balance = 32100000000
+
+	second := first.Add(24 * time.Hour)
+	require.NoError(t, d.Send(ctx, second))
+	require.Len(t, publisher.events, 2)
+
+	report = publisher.events[1].(*event.Digest)
+	assert.Equal(t, first, report.From.UTC())
+
+	change, known := report.Validators[0].GetBalanceChange()
+	assert.True(t, known)
+	assert.Equal(t, int64(100000000), change)
+}
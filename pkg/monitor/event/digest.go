@@ -0,0 +1,461 @@
+package event
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Digest is a scheduled summary of every monitored split and validator group.
+type Digest struct {
+	Timestamp  time.Time
+	From       time.Time
+	Period     string
+	Monitor    string
+	Splits     []*DigestSplit
+	Validators []*DigestValidatorGroup
+}
+
+// DigestSplit is the state of a split group at the time of the digest.
+type DigestSplit struct {
+	Group              string `json:"group"`
+	Address            string `json:"address"`
+	ControllerType     string `json:"controllerType"`
+	ExpectedController string `json:"expectedController"`
+	Controller         string `json:"controller"`
+	HashState          string `json:"hashState"`
+	// Balance is the split contract balance in wei.
+	Balance  string           `json:"balance"`
+	Accounts []*DigestAccount `json:"accounts"`
+	Safe     *DigestSafe      `json:"safe,omitempty"`
+}
+
+// DigestAccount is a split recipient.
+type DigestAccount struct {
+	Address string `json:"address"`
+	// SplitBalance is the getETHBalance of the recipient on the split contract in wei.
+	SplitBalance string `json:"splitBalance"`
+}
+
+// DigestSafe is the state of the Safe transaction queue of a split controller.
+type DigestSafe struct {
+	QueueSize                int    `json:"queueSize"`
+	RecoveryTransaction      string `json:"recoveryTransaction"`
+	RecoveryTransactionValid bool   `json:"recoveryTransactionValid"`
+	RecoveryTransactionNext  bool   `json:"recoveryTransactionNext"`
+	Confirmations            int    `json:"confirmations"`
+	ExpectedConfirmations    int    `json:"expectedConfirmations"`
+}
+
+// DigestValidatorGroup is the state of a validator group at the time of the digest.
+type DigestValidatorGroup struct {
+	Group      string         `json:"group"`
+	Validators int            `json:"validators"`
+	Statuses   map[string]int `json:"statuses"`
+	// TotalBalance is the total balance of the group in gwei.
+	TotalBalance uint64 `json:"totalBalance"`
+	// PreviousBalance is the total balance at the previous digest, nil if unknown.
+	PreviousBalance *uint64 `json:"previousBalance,omitempty"`
+}
+
+// DigestData is the payload data of a Digest event.
+type DigestData struct {
+	From       time.Time               `json:"from"`
+	Period     string                  `json:"period"`
+	Splits     []*DigestSplit          `json:"splits"`
+	Validators []*DigestValidatorGroup `json:"validators"`
+}
+
+const (
+	DigestType     = "digest"
+	DigestSeverity = SeverityInfo
+
+	DigestPeriodDaily  = "daily"
+	DigestPeriodWeekly = "weekly"
+)
+
+func NewDigest(timestamp, from time.Time, period, monitor string, splits []*DigestSplit, validators []*DigestValidatorGroup) *Digest {
+	return &Digest{
+		Timestamp:  timestamp,
+		From:       from,
+		Period:     period,
+		Monitor:    monitor,
+		Splits:     splits,
+		Validators: validators,
+	}
+}
+
+// IsDigest returns true if the event is a scheduled digest.
+func IsDigest(e Event) bool {
+	_, ok := Unwrap(e).(*Digest)
+
+	return ok
+}
+
+func (v *Digest) GetType() string {
+	return DigestType
+}
+
+// GetGroup returns an empty group as the digest covers every group.
+func (v *Digest) GetGroup() string {
+	return ""
+}
+
+func (v *Digest) GetSeverity() Severity {
+	return DigestSeverity
+}
+
+func (v *Digest) GetMonitor() string {
+	return v.Monitor
+}
+
+func (v *Digest) GetPayload() *Payload {
+	return NewPayload(v, v.Timestamp, &DigestData{
+		From:       v.From.UTC(),
+		Period:     v.Period,
+		Splits:     v.Splits,
+		Validators: v.Validators,
+	})
+}
+
+// GetBalanceChange returns the change of the total balance in gwei since the previous
+// digest, false if the previous balance is unknown.
+func (v *DigestValidatorGroup) GetBalanceChange() (int64, bool) {
+	if v.PreviousBalance == nil {
+		return 0, false
+	}
+
+	return int64(v.TotalBalance) - int64(*v.PreviousBalance), true //nolint:gosec // balances fit in int64
+}
+
+func (v *Digest) GetTitle(includeMonitor, includeGroup bool) string {
+	var sb strings.Builder
+
+	if includeMonitor {
+		sb.WriteString("[")
+		sb.WriteString(v.Monitor)
+		sb.WriteString("] ")
+	}
+
+	if v.Period == DigestPeriodWeekly {
+		sb.WriteString("Weekly digest")
+	} else {
+		sb.WriteString("Daily digest")
+	}
+
+	return sb.String()
+}
+
+func (v *Digest) GetDescriptionText(includeMonitor, includeGroup bool) string {
+	var sb strings.Builder
+
+	sb.WriteString("\nTimestamp: ")
+	sb.WriteString(v.Timestamp.UTC().Format("2006-01-02 15:04:05 UTC"))
+
+	if includeMonitor {
+		sb.WriteString("\nMonitor: ")
+		sb.WriteString(v.Monitor)
+	}
+
+	sb.WriteString("\nPeriod: ")
+	sb.WriteString(v.periodString())
+
+	for _, s := range v.Splits {
+		sb.WriteString("\n\nSplit: ")
+		sb.WriteString(s.Group)
+		sb.WriteString("\nAddress: ")
+		sb.WriteString(s.Address)
+		sb.WriteString("\nController: ")
+		sb.WriteString(s.controllerString())
+		sb.WriteString("\nHash State: ")
+		sb.WriteString(s.HashState)
+		sb.WriteString("\nBalance: ")
+		sb.WriteString(formatWei(s.Balance))
+
+		for _, a := range s.Accounts {
+			sb.WriteString("\nAccount ")
+			sb.WriteString(a.Address)
+			sb.WriteString(": ")
+			sb.WriteString(formatWei(a.SplitBalance))
+		}
+
+		if s.Safe != nil {
+			sb.WriteString("\nSafe Queue: ")
+			sb.WriteString(s.Safe.String())
+		}
+	}
+
+	for _, g := range v.Validators {
+		sb.WriteString("\n\nValidators: ")
+		sb.WriteString(g.Group)
+		sb.WriteString("\nCount: ")
+		sb.WriteString(fmt.Sprintf("%d", g.Validators))
+
+		for _, status := range g.sortedStatuses() {
+			sb.WriteString("\nStatus ")
+			sb.WriteString(status)
+			sb.WriteString(": ")
+			sb.WriteString(fmt.Sprintf("%d", g.Statuses[status]))
+		}
+
+		sb.WriteString("\nTotal Balance: ")
+		sb.WriteString(formatGwei(int64(g.TotalBalance))) //nolint:gosec // balances fit in int64
+		sb.WriteString("\nBalance Change: ")
+		sb.WriteString(g.balanceChangeString())
+	}
+
+	return sb.String()
+}
+
+func (v *Digest) GetDescriptionMarkdown(includeMonitor, includeGroup bool) string {
+	var sb strings.Builder
+
+	sb.WriteString("**Timestamp:** ")
+	sb.WriteString(v.Timestamp.UTC().Format("2006-01-02 15:04:05 UTC"))
+	sb.WriteString("\n")
+
+	if includeMonitor {
+		sb.WriteString("**Monitor:** ")
+		sb.WriteString(v.Monitor)
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("**Period:** ")
+	sb.WriteString(v.periodString())
+	sb.WriteString("\n")
+
+	for _, s := range v.Splits {
+		sb.WriteString("\n**Split ")
+		sb.WriteString(s.Group)
+		sb.WriteString("** `")
+		sb.WriteString(s.Address)
+		sb.WriteString("`\n")
+
+		sb.WriteString("**Controller:** ")
+		sb.WriteString(s.controllerString())
+		sb.WriteString("\n")
+
+		sb.WriteString("**Hash State:** ")
+		sb.WriteString(s.HashState)
+		sb.WriteString("\n")
+
+		sb.WriteString("**Balance:** ")
+		sb.WriteString(formatWei(s.Balance))
+		sb.WriteString("\n")
+
+		for _, a := range s.Accounts {
+			sb.WriteString("- `")
+			sb.WriteString(a.Address)
+			sb.WriteString("`: ")
+			sb.WriteString(formatWei(a.SplitBalance))
+			sb.WriteString("\n")
+		}
+
+		if s.Safe != nil {
+			sb.WriteString("**Safe Queue:** ")
+			sb.WriteString(s.Safe.String())
+			sb.WriteString("\n")
+		}
+	}
+
+	for _, g := range v.Validators {
+		sb.WriteString("\n**Validators ")
+		sb.WriteString(g.Group)
+		sb.WriteString("**\n")
+
+		sb.WriteString("**Count:** ")
+		sb.WriteString(fmt.Sprintf("%d", g.Validators))
+		sb.WriteString("\n")
+
+		for _, status := range g.sortedStatuses() {
+			sb.WriteString("- ")
+			sb.WriteString(status)
+			sb.WriteString(": ")
+			sb.WriteString(fmt.Sprintf("%d", g.Statuses[status]))
+			sb.WriteString("\n")
+		}
+
+		sb.WriteString("**Total Balance:** ")
+		sb.WriteString(formatGwei(int64(g.TotalBalance))) //nolint:gosec // balances fit in int64
+		sb.WriteString("\n")
+
+		sb.WriteString("**Balance Change:** ")
+		sb.WriteString(g.balanceChangeString())
+		sb.WriteString("\n")
+	}
+
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+func (v *Digest) GetDescriptionHTML(includeMonitor, includeGroup bool) string {
+	var sb strings.Builder
+
+	sb.WriteString("<p><strong>Timestamp:</strong> ")
+	sb.WriteString(v.Timestamp.UTC().Format("2006-01-02 15:04:05 UTC"))
+	sb.WriteString("</p>")
+
+	if includeMonitor {
+		sb.WriteString("<p><strong>Monitor:</strong> ")
+		sb.WriteString(v.Monitor)
+		sb.WriteString("</p>")
+	}
+
+	sb.WriteString("<p><strong>Period:</strong> ")
+	sb.WriteString(v.periodString())
+	sb.WriteString("</p>")
+
+	for _, s := range v.Splits {
+		sb.WriteString("<h3>Split ")
+		sb.WriteString(s.Group)
+		sb.WriteString("</h3>")
+
+		sb.WriteString("<table>")
+		writeHTMLRow(&sb, "Address", s.Address)
+		writeHTMLRow(&sb, "Controller", s.controllerString())
+		writeHTMLRow(&sb, "Hash State", s.HashState)
+		writeHTMLRow(&sb, "Balance", formatWei(s.Balance))
+
+		if s.Safe != nil {
+			writeHTMLRow(&sb, "Safe Queue", s.Safe.String())
+		}
+
+		sb.WriteString("</table>")
+
+		if len(s.Accounts) > 0 {
+			sb.WriteString("<table><tr><th>Account</th><th>Split Balance</th></tr>")
+
+			for _, a := range s.Accounts {
+				sb.WriteString("<tr><td>")
+				sb.WriteString(a.Address)
+				sb.WriteString("</td><td>")
+				sb.WriteString(formatWei(a.SplitBalance))
+				sb.WriteString("</td></tr>")
+			}
+
+			sb.WriteString("</table>")
+		}
+	}
+
+	for _, g := range v.Validators {
+		sb.WriteString("<h3>Validators ")
+		sb.WriteString(g.Group)
+		sb.WriteString("</h3>")
+
+		sb.WriteString("<table>")
+		writeHTMLRow(&sb, "Count", fmt.Sprintf("%d", g.Validators))
+
+		for _, status := range g.sortedStatuses() {
+			writeHTMLRow(&sb, status, fmt.Sprintf("%d", g.Statuses[status]))
+		}
+
+		writeHTMLRow(&sb, "Total Balance", formatGwei(int64(g.TotalBalance))) //nolint:gosec // balances fit in int64
+		writeHTMLRow(&sb, "Balance Change", g.balanceChangeString())
+		sb.WriteString("</table>")
+	}
+
+	return sb.String()
+}
+
+func (v *Digest) periodString() string {
+	if v.From.IsZero() {
+		return "until " + v.Timestamp.UTC().Format("2006-01-02 15:04 UTC")
+	}
+
+	return v.From.UTC().Format("2006-01-02 15:04 UTC") + " - " + v.Timestamp.UTC().Format("2006-01-02 15:04 UTC")
+}
+
+func (s *DigestSplit) controllerString() string {
+	controller := s.Controller
+	if controller == "" {
+		controller = "unknown"
+	}
+
+	if s.ControllerType != "" {
+		controller += " (" + s.ControllerType + ")"
+	}
+
+	if s.Controller != "" && s.ExpectedController != "" && !strings.EqualFold(s.Controller, s.ExpectedController) {
+		controller += ", expected " + s.ExpectedController
+	}
+
+	return controller
+}
+
+func (s *DigestSafe) String() string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("%d transaction(s)", s.QueueSize))
+
+	if s.RecoveryTransaction == "" {
+		sb.WriteString(", no recovery transaction")
+
+		return sb.String()
+	}
+
+	if s.RecoveryTransactionValid {
+		sb.WriteString(", valid recovery transaction")
+	} else {
+		sb.WriteString(", invalid recovery transaction")
+	}
+
+	if !s.RecoveryTransactionNext {
+		sb.WriteString(" not next in queue")
+	}
+
+	sb.WriteString(fmt.Sprintf(", %d/%d confirmations", s.Confirmations, s.ExpectedConfirmations))
+
+	return sb.String()
+}
+
+func (v *DigestValidatorGroup) sortedStatuses() []string {
+	statuses := make([]string, 0, len(v.Statuses))
+
+	for status := range v.Statuses {
+		statuses = append(statuses, status)
+	}
+
+	sort.Strings(statuses)
+
+	return statuses
+}
+
+func (v *DigestValidatorGroup) balanceChangeString() string {
+	change, ok := v.GetBalanceChange()
+	if !ok {
+		return "unknown"
+	}
+
+	if change > 0 {
+		return "+" + formatGwei(change)
+	}
+
+	return formatGwei(change)
+}
+
+func writeHTMLRow(sb *strings.Builder, name, value string) {
+	sb.WriteString("<tr><td><strong>")
+	sb.WriteString(name)
+	sb.WriteString("</strong></td><td>")
+	sb.WriteString(value)
+	sb.WriteString("</td></tr>")
+}
+
+// formatWei formats a wei amount as ETH, unparsable amounts are returned as is.
+func formatWei(wei string) string {
+	if wei == "" {
+		return "unknown"
+	}
+
+	amount, ok := new(big.Float).SetString(wei)
+	if !ok {
+		return wei
+	}
+
+	return new(big.Float).Quo(amount, big.NewFloat(1e18)).Text('f', 4) + " ETH"
+}
+
+func formatGwei(gwei int64) string {
+	return fmt.Sprintf("%.4f ETH", float64(gwei)/1e9)
+}
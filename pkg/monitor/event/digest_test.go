@@ -0,0 +1,87 @@
+package event_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+)
+
+func TestDigest(t *testing.T) {
+	timestamp := time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC)
+	from := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	previous := uint64(64000000000)
+
+	evt := event.NewDigest(timestamp, from, event.DigestPeriodDaily, "test_monitor", []*event.DigestSplit{
+		{
+			Group:              "split-1",
+			Address:            "0x123",
+			ControllerType:     "safe",
+			ExpectedController: "0x456",
+			Controller:         "0x789",
+			HashState:          "stable",
+			Balance:            "1500000000000000000",
+			Accounts: []*event.DigestAccount{
+				{Address: "0xaaa", SplitBalance: "250000000000000000"},
+			},
+			Safe: &event.DigestSafe{QueueSize: 1, RecoveryTransaction: "tx", RecoveryTransactionValid: true, RecoveryTransactionNext: true, Confirmations: 1, ExpectedConfirmations: 2},
+		},
+	}, []*event.DigestValidatorGroup{
+		{
+			Group:           "validators-1",
+			Validators:      2,
+			Statuses:        map[string]int{"exited_unslashed": 1, "active_online": 1},
+			TotalBalance:    64100000000,
+			PreviousBalance: &previous,
+		},
+	})
+
+	// Verify it implements Event interface
+	var _ event.Event = evt
+
+	assert.True(t, event.IsDigest(evt))
+	assert.False(t, event.IsResolved(evt))
+	assert.Equal(t, "📊", event.Emoji(evt))
+	assert.Equal(t, event.DigestType, evt.GetType())
+	assert.Equal(t, event.SeverityInfo, evt.GetSeverity())
+	assert.Equal(t, "", evt.GetGroup())
+	assert.Equal(t, "[test_monitor] Daily digest", evt.GetTitle(true, true))
+
+	assert.Equal(t, `
+Timestamp: 2024-01-02 09:00:00 UTC
+Monitor: test_monitor
+Period: 2024-01-01 09:00 UTC - 2024-01-02 09:00 UTC
+
+Split: split-1
+Address: 0x123
+Controller: 0x789 (safe), expected 0x456
+Hash State: stable
+Balance: 1.5000 ETH
+Account 0xaaa: 0.2500 ETH
+Safe Queue: 1 transaction(s), valid recovery transaction, 1/2 confirmations
+
+Validators: validators-1
+Count: 2
+Status active_online: 1
+Status exited_unslashed: 1
+Total Balance: 64.1000 ETH
+Balance Change: +0.1000 ETH`, evt.GetDescriptionText(true, true))
+
+	markdown := evt.GetDescriptionMarkdown(false, false)
+	assert.Contains(t, markdown, "**Split split-1** `0x123`")
+	assert.Contains(t, markdown, "- `0xaaa`: 0.2500 ETH")
+	assert.Contains(t, markdown, "**Balance Change:** +0.1000 ETH")
+	assert.NotContains(t, markdown, "**Monitor:**")
+
+	html := evt.GetDescriptionHTML(true, true)
+	assert.Contains(t, html, "<h3>Split split-1</h3>")
+	assert.Contains(t, html, "<tr><td>0xaaa</td><td>0.2500 ETH</td></tr>")
+	assert.Contains(t, html, "<tr><td><strong>active_online</strong></td><td>1</td></tr>")
+
+	weekly := event.NewDigest(timestamp, time.Time{}, event.DigestPeriodWeekly, "test_monitor", nil, []*event.DigestValidatorGroup{{Group: "validators-1"}})
+	assert.Equal(t, "Weekly digest", weekly.GetTitle(false, false))
+	assert.Contains(t, weekly.GetDescriptionText(false, false), "Period: until 2024-01-02 09:00 UTC")
+	assert.Contains(t, weekly.GetDescriptionText(false, false), "Balance Change: unknown")
+}
@@ -8,4 +8,102 @@ type Event interface {
 	GetDescriptionMarkdown(includeMonitor, includeGroup bool) string
 	GetDescriptionHTML(includeMonitor, includeGroup bool) string
 	GetGroup() string
+	GetSeverity() Severity
+	// GetPayload returns the machine-readable representation of the event.
+	GetPayload() *Payload
+}
+
+// Subject is implemented by events that relate to a specific split, Safe or validator.
+type Subject interface {
+	GetSubject() string
+}
+
+// Wrapper is implemented by events that decorate another event without changing what
+// it is, eg. to render it with custom templates.
+type Wrapper interface {
+	Unwrap() Event
+}
+
+// Unwrap returns the innermost event of a wrapped event.
+func Unwrap(e Event) Event {
+	for {
+		w, ok := e.(Wrapper)
+		if !ok {
+			return e
+		}
+
+		e = w.Unwrap()
+	}
+}
+
+// GetSubject returns the split address, Safe address or validator pubkey the event relates to.
+func GetSubject(e Event) string {
+	e = Unwrap(e)
+
+	if s, ok := e.(Subject); ok {
+		return s.GetSubject()
+	}
+
+	return ""
+}
+
+// Notice is implemented by events that report something that happened once, eg. a contract
+// log, rather than an alert that keeps firing until it is resolved.
+type Notice interface {
+	IsNotice() bool
+}
+
+// IsNotice returns true if the event is a notice that is never resolved.
+func IsNotice(e Event) bool {
+	n, ok := Unwrap(e).(Notice)
+
+	return ok && n.IsNotice()
+}
+
+// GetAlertType returns the type of the alert the event belongs to, resolved events
+// return the type of the alert they resolve and reminders the type of the alert that
+// is still firing.
+func GetAlertType(e Event) string {
+	switch v := Unwrap(e).(type) {
+	case *Resolved:
+		return v.AlertType
+	case *Reminder:
+		return GetAlertType(v.Event)
+	}
+
+	return e.GetType()
+}
+
+// Emojier is implemented by events that override the emoji of their title, eg. a title
+// template that renders the emoji itself.
+type Emojier interface {
+	GetEmoji() string
+}
+
+// Emoji returns the emoji sources prefix the title of the event with, empty if the
+// title has no prefix.
+func Emoji(e Event) string {
+	if v, ok := e.(Emojier); ok {
+		return v.GetEmoji()
+	}
+
+	switch {
+	case IsResolved(e):
+		return "✅"
+	case IsDigest(e):
+		return "📊"
+	}
+
+	return "🚨"
+}
+
+// EmojiPrefix returns the emoji of the event followed by a space, empty if the title has
+// no prefix.
+func EmojiPrefix(e Event) string {
+	emoji := Emoji(e)
+	if emoji == "" {
+		return ""
+	}
+
+	return emoji + " "
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -58,6 +59,14 @@ func (m *MockEvent) GetGroup() string {
 	return m.group
 }
 
+func (m *MockEvent) GetSeverity() event.Severity {
+	return event.SeverityInfo
+}
+
+func (m *MockEvent) GetPayload() *event.Payload {
+	return event.NewPayload(m, time.Time{}, nil)
+}
+
 func TestEventInterface(t *testing.T) {
 	tests := []struct {
 		name        string
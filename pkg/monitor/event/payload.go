@@ -0,0 +1,77 @@
+package event
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// SchemaVersion is the version of the payload schema, it is incremented on breaking
+// changes to the payload or the data of an event type.
+const SchemaVersion = 1
+
+// Payload is the machine-readable representation of an event.
+type Payload struct {
+	SchemaVersion int    `json:"schemaVersion"`
+	Type          string `json:"type"`
+	// AlertType is the type of the alert the event belongs to, see GetAlertType.
+	AlertType string    `json:"alertType"`
+	Monitor   string    `json:"monitor"`
+	Group     string    `json:"group"`
+	Severity  Severity  `json:"severity"`
+	Subject   string    `json:"subject,omitempty"`
+	Resolved  bool      `json:"resolved"`
+	Timestamp time.Time `json:"timestamp"`
+	// Data holds the fields of the event type, eg. *split.ControllerData. It is decoded as
+	// a map when unmarshalling.
+	Data interface{} `json:"data"`
+}
+
+// NewPayload creates the payload of an event with the fields of the event type.
+func NewPayload(e Event, timestamp time.Time, data interface{}) *Payload {
+	return &Payload{
+		SchemaVersion: SchemaVersion,
+		Type:          e.GetType(),
+		AlertType:     GetAlertType(e),
+		Monitor:       e.GetMonitor(),
+		Group:         e.GetGroup(),
+		Severity:      e.GetSeverity(),
+		Subject:       GetSubject(e),
+		Resolved:      IsResolved(e),
+		Timestamp:     timestamp.UTC(),
+		Data:          data,
+	}
+}
+
+// Marshal returns the JSON encoded payload of the event.
+func Marshal(e Event) ([]byte, error) {
+	return json.Marshal(e.GetPayload())
+}
+
+// Unmarshal decodes a JSON encoded payload, the data of the event type is decoded into
+// data if it is not nil.
+func Unmarshal(b []byte, data interface{}) (*Payload, error) {
+	var raw struct {
+		Payload
+		Data json.RawMessage `json:"data"`
+	}
+
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, err
+	}
+
+	payload := raw.Payload
+
+	if data == nil {
+		data = &map[string]interface{}{}
+	}
+
+	if len(raw.Data) > 0 && string(raw.Data) != "null" {
+		if err := json.Unmarshal(raw.Data, data); err != nil {
+			return nil, err
+		}
+
+		payload.Data = data
+	}
+
+	return &payload, nil
+}
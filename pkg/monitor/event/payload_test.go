@@ -0,0 +1,115 @@
+package event_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/split"
+)
+
+func TestPayload(t *testing.T) {
+	timestamp := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	since := timestamp.Add(-time.Hour)
+	inner := split.NewController(since, "test_monitor", "test_group", "0x123", "0x456", "0x789")
+
+	tests := []struct {
+		name          string
+		event         event.Event
+		wantType      string
+		wantAlertType string
+		wantResolved  bool
+		wantTimestamp time.Time
+		wantData      map[string]interface{}
+	}{
+		{
+			name:          "alert",
+			event:         inner,
+			wantType:      split.ControllerType,
+			wantAlertType: split.ControllerType,
+			wantTimestamp: since,
+			wantData: map[string]interface{}{
+				"splitAddress":       "0x123",
+				"expectedController": "0x456",
+				"actualController":   "0x789",
+			},
+		},
+		{
+			name:          "resolved",
+			event:         event.NewResolved(timestamp, since, "test_monitor", "test_group", split.ControllerType, split.ControllerSeverity, "Split controller has changed", "0x123"),
+			wantType:      split.ControllerType + event.ResolvedTypeSuffix,
+			wantAlertType: split.ControllerType,
+			wantResolved:  true,
+			wantTimestamp: timestamp,
+			wantData: map[string]interface{}{
+				"alertTitle":      "Split controller has changed",
+				"since":           "2024-01-01T11:00:00Z",
+				"durationSeconds": float64(3600),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := event.Marshal(tt.event)
+			require.NoError(t, err)
+
+			payload, err := event.Unmarshal(b, nil)
+			require.NoError(t, err)
+
+			assert.Equal(t, event.SchemaVersion, payload.SchemaVersion)
+			assert.Equal(t, tt.wantType, payload.Type)
+			assert.Equal(t, tt.wantAlertType, payload.AlertType)
+			assert.Equal(t, "test_monitor", payload.Monitor)
+			assert.Equal(t, "test_group", payload.Group)
+			assert.Equal(t, split.ControllerSeverity, payload.Severity)
+			assert.Equal(t, "0x123", payload.Subject)
+			assert.Equal(t, tt.wantResolved, payload.Resolved)
+			assert.True(t, tt.wantTimestamp.Equal(payload.Timestamp))
+			assert.Equal(t, &tt.wantData, payload.Data)
+		})
+	}
+}
+
+func TestPayloadReminder(t *testing.T) {
+	timestamp := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	since := timestamp.Add(-2 * time.Hour)
+	reminder := event.NewReminder(timestamp, since, split.NewController(since, "test_monitor", "test_group", "0x123", "0x456", "0x789"))
+
+	b, err := json.Marshal(reminder.GetPayload())
+	require.NoError(t, err)
+
+	var data event.ReminderData
+
+	payload, err := event.Unmarshal(b, &data)
+	require.NoError(t, err)
+
+	assert.Equal(t, split.ControllerType+event.ReminderTypeSuffix, payload.Type)
+	assert.Equal(t, split.ControllerType, payload.AlertType)
+	assert.Equal(t, int64(7200), data.DurationSeconds)
+	require.NotNil(t, data.Event)
+	assert.Equal(t, split.ControllerType, data.Event.Type)
+	assert.Equal(t, event.SchemaVersion, data.Event.SchemaVersion)
+}
+
+func TestUnmarshalTypedData(t *testing.T) {
+	e := split.NewHashUnknownState(time.Now(), "test_monitor", "test_group", "0x123", "0xaaa", "0xbbb")
+
+	b, err := event.Marshal(e)
+	require.NoError(t, err)
+
+	var data split.HashUnknownStateData
+
+	payload, err := event.Unmarshal(b, &data)
+	require.NoError(t, err)
+
+	assert.Equal(t, &data, payload.Data)
+	assert.Equal(t, split.HashUnknownStateData{SplitAddress: "0x123", ExpectedHash: "0xaaa", ActualHash: "0xbbb"}, data)
+
+	_, err = event.Unmarshal([]byte("not json"), nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,140 @@
+package event
+
+import (
+	"strings"
+	"time"
+)
+
+// Reminder is published periodically while an alert is still firing.
+type Reminder struct {
+	Timestamp time.Time
+	Since     time.Time
+	Event     Event
+}
+
+// ReminderData is the payload data of a Reminder event.
+type ReminderData struct {
+	// Since is when the alert started firing, zero if unknown.
+	Since           time.Time `json:"since"`
+	DurationSeconds int64     `json:"durationSeconds"`
+	// Event is the payload of the alert that is still firing.
+	Event *Payload `json:"event"`
+}
+
+const (
+	ReminderTypeSuffix = "_reminder"
+)
+
+func NewReminder(timestamp, since time.Time, e Event) *Reminder {
+	return &Reminder{
+		Timestamp: timestamp,
+		Since:     since,
+		Event:     e,
+	}
+}
+
+// IsReminder returns true if the event is a reminder of a firing alert.
+func IsReminder(e Event) bool {
+	_, ok := Unwrap(e).(*Reminder)
+
+	return ok
+}
+
+func (v *Reminder) GetType() string {
+	return v.Event.GetType() + ReminderTypeSuffix
+}
+
+func (v *Reminder) GetGroup() string {
+	return v.Event.GetGroup()
+}
+
+func (v *Reminder) GetSeverity() Severity {
+	return v.Event.GetSeverity()
+}
+
+func (v *Reminder) GetSubject() string {
+	return GetSubject(v.Event)
+}
+
+func (v *Reminder) GetMonitor() string {
+	return v.Event.GetMonitor()
+}
+
+func (v *Reminder) GetPayload() *Payload {
+	return NewPayload(v, v.Timestamp, &ReminderData{
+		Since:           v.Since.UTC(),
+		DurationSeconds: int64(v.GetDuration().Seconds()),
+		Event:           v.Event.GetPayload(),
+	})
+}
+
+func (v *Reminder) GetDuration() time.Duration {
+	if v.Since.IsZero() {
+		return 0
+	}
+
+	return v.Timestamp.Sub(v.Since).Round(time.Second)
+}
+
+func (v *Reminder) GetTitle(includeMonitor, includeGroup bool) string {
+	var sb strings.Builder
+
+	if includeMonitor {
+		sb.WriteString("[")
+		sb.WriteString(v.GetMonitor())
+		sb.WriteString("] ")
+	}
+
+	sb.WriteString("Still firing: ")
+	sb.WriteString(v.Event.GetTitle(false, includeGroup))
+
+	return sb.String()
+}
+
+func (v *Reminder) GetDescriptionText(includeMonitor, includeGroup bool) string {
+	var sb strings.Builder
+
+	sb.WriteString(v.Event.GetDescriptionText(includeMonitor, includeGroup))
+
+	if !v.Since.IsZero() {
+		sb.WriteString("\nStill Firing Since: ")
+		sb.WriteString(v.Since.UTC().Format("2006-01-02 15:04:05 UTC"))
+		sb.WriteString("\nDuration: ")
+		sb.WriteString(v.GetDuration().String())
+	}
+
+	return sb.String()
+}
+
+func (v *Reminder) GetDescriptionMarkdown(includeMonitor, includeGroup bool) string {
+	var sb strings.Builder
+
+	sb.WriteString(strings.TrimSuffix(v.Event.GetDescriptionMarkdown(includeMonitor, includeGroup), "\n"))
+
+	if !v.Since.IsZero() {
+		sb.WriteString("\n**Still Firing Since:** ")
+		sb.WriteString(v.Since.UTC().Format("2006-01-02 15:04:05 UTC"))
+		sb.WriteString("\n**Duration:** ")
+		sb.WriteString(v.GetDuration().String())
+	}
+
+	return sb.String()
+}
+
+func (v *Reminder) GetDescriptionHTML(includeMonitor, includeGroup bool) string {
+	var sb strings.Builder
+
+	sb.WriteString(v.Event.GetDescriptionHTML(includeMonitor, includeGroup))
+
+	if !v.Since.IsZero() {
+		sb.WriteString("<p><strong>Still Firing Since:</strong> ")
+		sb.WriteString(v.Since.UTC().Format("2006-01-02 15:04:05 UTC"))
+		sb.WriteString("</p>")
+
+		sb.WriteString("<p><strong>Duration:</strong> ")
+		sb.WriteString(v.GetDuration().String())
+		sb.WriteString("</p>")
+	}
+
+	return sb.String()
+}
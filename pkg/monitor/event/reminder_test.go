@@ -0,0 +1,40 @@
+package event_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/split"
+)
+
+func TestReminder(t *testing.T) {
+	timestamp := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	since := time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC)
+
+	inner := split.NewController(timestamp, "test_monitor", "test_group", "0x123", "0xaaa", "0xbbb")
+	evt := event.NewReminder(timestamp, since, inner)
+
+	// Verify it implements Event interface
+	var _ event.Event = evt
+
+	assert.True(t, event.IsReminder(evt))
+	assert.False(t, event.IsReminder(inner))
+	assert.False(t, event.IsResolved(evt))
+	assert.Equal(t, "split_controller_reminder", evt.GetType())
+	assert.Equal(t, split.ControllerType, event.GetAlertType(evt))
+	assert.Equal(t, "test_monitor", evt.GetMonitor())
+	assert.Equal(t, "test_group", evt.GetGroup())
+	assert.Equal(t, "0x123", event.GetSubject(evt))
+	assert.Equal(t, split.ControllerSeverity, evt.GetSeverity())
+	assert.Equal(t, 90*time.Minute, evt.GetDuration())
+	assert.Equal(t, "[test_monitor] Still firing: Split controller has changed", evt.GetTitle(true, true))
+	assert.Equal(t, "Still firing: Split controller has changed", evt.GetTitle(false, false))
+	assert.Equal(t, inner.GetDescriptionText(true, true)+`
+Still Firing Since: 2024-01-01 10:30:00 UTC
+Duration: 1h30m0s`, evt.GetDescriptionText(true, true))
+	assert.Contains(t, evt.GetDescriptionMarkdown(true, true), "**Still Firing Since:** 2024-01-01 10:30:00 UTC")
+	assert.Contains(t, evt.GetDescriptionHTML(true, true), "<p><strong>Duration:</strong> 1h30m0s</p>")
+}
@@ -0,0 +1,210 @@
+package event
+
+import (
+	"strings"
+	"time"
+)
+
+// Resolved is published when a previously alerting condition has cleared.
+type Resolved struct {
+	Timestamp  time.Time
+	Since      time.Time
+	AlertType  string
+	AlertTitle string
+	// Severity is the severity of the alert that was resolved, so it is routed to the same sources.
+	Severity Severity
+	Subject  string
+	Group    string
+	Monitor  string
+}
+
+// ResolvedData is the payload data of a Resolved event.
+type ResolvedData struct {
+	AlertTitle string `json:"alertTitle"`
+	// Since is when the alert started firing, zero if unknown.
+	Since           time.Time `json:"since"`
+	DurationSeconds int64     `json:"durationSeconds"`
+}
+
+const (
+	ResolvedTypeSuffix = "_resolved"
+)
+
+func NewResolved(timestamp, since time.Time, monitor, group, alertType string, severity Severity, alertTitle, subject string) *Resolved {
+	return &Resolved{
+		Timestamp:  timestamp,
+		Since:      since,
+		AlertType:  alertType,
+		AlertTitle: alertTitle,
+		Severity:   severity,
+		Subject:    subject,
+		Group:      group,
+		Monitor:    monitor,
+	}
+}
+
+// IsResolved returns true if the event signals that an alert has cleared.
+func IsResolved(e Event) bool {
+	_, ok := Unwrap(e).(*Resolved)
+
+	return ok
+}
+
+func (v *Resolved) GetType() string {
+	return v.AlertType + ResolvedTypeSuffix
+}
+
+func (v *Resolved) GetGroup() string {
+	return v.Group
+}
+
+func (v *Resolved) GetSeverity() Severity {
+	return v.Severity
+}
+
+func (v *Resolved) GetSubject() string {
+	return v.Subject
+}
+
+func (v *Resolved) GetMonitor() string {
+	return v.Monitor
+}
+
+func (v *Resolved) GetPayload() *Payload {
+	return NewPayload(v, v.Timestamp, &ResolvedData{
+		AlertTitle:      v.AlertTitle,
+		Since:           v.Since.UTC(),
+		DurationSeconds: int64(v.GetDuration().Seconds()),
+	})
+}
+
+func (v *Resolved) GetDuration() time.Duration {
+	if v.Since.IsZero() {
+		return 0
+	}
+
+	return v.Timestamp.Sub(v.Since).Round(time.Second)
+}
+
+func (v *Resolved) GetTitle(includeMonitor, includeGroup bool) string {
+	var sb strings.Builder
+
+	if includeMonitor {
+		sb.WriteString("[")
+		sb.WriteString(v.Monitor)
+		sb.WriteString("] ")
+	}
+
+	sb.WriteString("Resolved: ")
+	sb.WriteString(v.AlertTitle)
+
+	return sb.String()
+}
+
+func (v *Resolved) GetDescriptionText(includeMonitor, includeGroup bool) string {
+	var sb strings.Builder
+
+	sb.WriteString("\nTimestamp: ")
+	sb.WriteString(v.Timestamp.UTC().Format("2006-01-02 15:04:05 UTC"))
+
+	if includeMonitor {
+		sb.WriteString("\nMonitor: ")
+		sb.WriteString(v.Monitor)
+	}
+
+	if includeGroup {
+		sb.WriteString("\nGroup: ")
+		sb.WriteString(v.Group)
+	}
+
+	sb.WriteString("\nAlert: ")
+	sb.WriteString(v.AlertType)
+	sb.WriteString("\nSubject: ")
+	sb.WriteString(v.Subject)
+
+	if !v.Since.IsZero() {
+		sb.WriteString("\nAlerting Since: ")
+		sb.WriteString(v.Since.UTC().Format("2006-01-02 15:04:05 UTC"))
+		sb.WriteString("\nDuration: ")
+		sb.WriteString(v.GetDuration().String())
+	}
+
+	return sb.String()
+}
+
+func (v *Resolved) GetDescriptionMarkdown(includeMonitor, includeGroup bool) string {
+	var sb strings.Builder
+
+	sb.WriteString("**Timestamp:** ")
+	sb.WriteString(v.Timestamp.UTC().Format("2006-01-02 15:04:05 UTC"))
+	sb.WriteString("\n")
+
+	if includeMonitor {
+		sb.WriteString("**Monitor:** ")
+		sb.WriteString(v.Monitor)
+		sb.WriteString("\n")
+	}
+
+	if includeGroup {
+		sb.WriteString("**Group:** ")
+		sb.WriteString(v.Group)
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("**Alert:** ")
+	sb.WriteString(v.AlertType)
+	sb.WriteString("\n")
+
+	sb.WriteString("**Subject:** `")
+	sb.WriteString(v.Subject)
+	sb.WriteString("`")
+
+	if !v.Since.IsZero() {
+		sb.WriteString("\n**Alerting Since:** ")
+		sb.WriteString(v.Since.UTC().Format("2006-01-02 15:04:05 UTC"))
+		sb.WriteString("\n**Duration:** ")
+		sb.WriteString(v.GetDuration().String())
+	}
+
+	return sb.String()
+}
+
+func (v *Resolved) GetDescriptionHTML(includeMonitor, includeGroup bool) string {
+	var sb strings.Builder
+
+	sb.WriteString("<p><strong>Timestamp:</strong> ")
+	sb.WriteString(v.Timestamp.UTC().Format("2006-01-02 15:04:05 UTC"))
+	sb.WriteString("</p>")
+
+	if includeMonitor {
+		sb.WriteString("<p><strong>Monitor:</strong> ")
+		sb.WriteString(v.Monitor)
+		sb.WriteString("</p>")
+	}
+
+	if includeGroup {
+		sb.WriteString("<p><strong>Group:</strong> ")
+		sb.WriteString(v.Group)
+		sb.WriteString("</p>")
+	}
+
+	sb.WriteString("<p><strong>Alert:</strong> ")
+	sb.WriteString(v.AlertType)
+	sb.WriteString("</p>")
+
+	sb.WriteString("<p><strong>Subject:</strong> ")
+	sb.WriteString(v.Subject)
+	sb.WriteString("</p>")
+
+	if !v.Since.IsZero() {
+		sb.WriteString("<p><strong>Alerting Since:</strong> ")
+		sb.WriteString(v.Since.UTC().Format("2006-01-02 15:04:05 UTC"))
+		sb.WriteString("</p>")
+
+		sb.WriteString("<p><strong>Duration:</strong> ")
+		sb.WriteString(v.GetDuration().String())
+		sb.WriteString("</p>")
+	}
+
+	return sb.String()
+}
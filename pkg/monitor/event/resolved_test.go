@@ -0,0 +1,90 @@
+package event_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+)
+
+func TestResolved(t *testing.T) {
+	tests := []struct {
+		name          string
+		timestamp     time.Time
+		since         time.Time
+		monitor       string
+		group         string
+		alertType     string
+		alertSeverity event.Severity
+		alertTitle    string
+		subject       string
+		wantType      string
+		wantTitle     string
+		wantDesc      string
+		wantDuration  time.Duration
+	}{
+		{
+			name:          "basic event",
+			timestamp:     time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+			since:         time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC),
+			monitor:       "test_monitor",
+			group:         "test_group",
+			alertType:     "split_hash_unknown_state",
+			alertSeverity: event.SeverityCritical,
+			alertTitle:    "Split hash is in unknown state",
+			subject:       "0x123",
+			wantType:      "split_hash_unknown_state_resolved",
+			wantTitle:     "[test_monitor] Resolved: Split hash is in unknown state",
+			wantDuration:  90 * time.Minute,
+			wantDesc: `
+Timestamp: 2024-01-01 12:00:00 UTC
+Monitor: test_monitor
+Group: test_group
+Alert: split_hash_unknown_state
+Subject: 0x123
+Alerting Since: 2024-01-01 10:30:00 UTC
+Duration: 1h30m0s`,
+		},
+		{
+			name:          "unknown start",
+			timestamp:     time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+			monitor:       "test_monitor",
+			group:         "test_group",
+			alertType:     "validator_status",
+			alertSeverity: event.SeverityWarning,
+			alertTitle:    "Validator has unexpectedly status",
+			subject:       "0xabc",
+			wantType:      "validator_status_resolved",
+			wantTitle:     "[test_monitor] Resolved: Validator has unexpectedly status",
+			wantDuration:  0,
+			wantDesc: `
+Timestamp: 2024-01-01 12:00:00 UTC
+Monitor: test_monitor
+Group: test_group
+Alert: validator_status
+Subject: 0xabc`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evt := event.NewResolved(tt.timestamp, tt.since, tt.monitor, tt.group, tt.alertType, tt.alertSeverity, tt.alertTitle, tt.subject)
+
+			// Verify it implements Event interface
+			var _ event.Event = evt
+
+			assert.True(t, event.IsResolved(evt))
+			assert.Equal(t, tt.wantType, evt.GetType())
+			assert.Equal(t, tt.monitor, evt.GetMonitor())
+			assert.Equal(t, tt.group, evt.GetGroup())
+			assert.Equal(t, tt.alertSeverity, evt.GetSeverity())
+			assert.Equal(t, tt.wantTitle, evt.GetTitle(true, true))
+			assert.Equal(t, tt.wantDesc, evt.GetDescriptionText(true, true))
+			assert.Equal(t, tt.wantDuration, evt.GetDuration())
+		})
+	}
+
+	assert.False(t, event.IsResolved(NewMockEvent("monitor", "type", "title", "desc", "group")))
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strings"
 	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
 )
 
 type RecoveryTransactionConfirmations struct {
@@ -16,8 +18,17 @@ type RecoveryTransactionConfirmations struct {
 	ExpectedConfirmations int
 }
 
+// RecoveryTransactionConfirmationsData is the payload data of a RecoveryTransactionConfirmations event.
+type RecoveryTransactionConfirmationsData struct {
+	SafeAddress           string `json:"safeAddress"`
+	RecoveryTransactionID string `json:"recoveryTransactionId"`
+	NumConfirmations      int    `json:"numConfirmations"`
+	ExpectedConfirmations int    `json:"expectedConfirmations"`
+}
+
 const (
-	RecoveryTransactionConfirmationsType = "safe_recovery_transaction_confirmations"
+	RecoveryTransactionConfirmationsType     = "safe_recovery_transaction_confirmations"
+	RecoveryTransactionConfirmationsSeverity = event.SeverityWarning
 )
 
 func NewRecoveryTransactionConfirmations(timestamp time.Time, monitor, group, safeAddress, recoveryTxID string, numConfirmations, expectedConfirmations int) *RecoveryTransactionConfirmations {
@@ -40,10 +51,27 @@ func (v *RecoveryTransactionConfirmations) GetGroup() string {
 	return v.Group
 }
 
+func (v *RecoveryTransactionConfirmations) GetSeverity() event.Severity {
+	return RecoveryTransactionConfirmationsSeverity
+}
+
+func (v *RecoveryTransactionConfirmations) GetSubject() string {
+	return v.SafeAddress
+}
+
 func (v *RecoveryTransactionConfirmations) GetMonitor() string {
 	return v.Monitor
 }
 
+func (v *RecoveryTransactionConfirmations) GetPayload() *event.Payload {
+	return event.NewPayload(v, v.Timestamp, &RecoveryTransactionConfirmationsData{
+		SafeAddress:           v.SafeAddress,
+		RecoveryTransactionID: v.RecoveryTransactionID,
+		NumConfirmations:      v.NumConfirmations,
+		ExpectedConfirmations: v.ExpectedConfirmations,
+	})
+}
+
 func (v *RecoveryTransactionConfirmations) GetTitle(includeMonitor, includeGroup bool) string {
 	var sb strings.Builder
 
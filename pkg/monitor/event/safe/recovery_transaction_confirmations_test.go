@@ -115,3 +115,21 @@ Expected Confirmations: 2`,
 		})
 	}
 }
+
+func TestRecoveryTransactionConfirmationsPayload(t *testing.T) {
+	timestamp := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	e := safe.NewRecoveryTransactionConfirmations(timestamp, "test_monitor", "test_group", "0x123", "0xtx", 1, 3)
+
+	payload := e.GetPayload()
+
+	assert.Equal(t, event.SchemaVersion, payload.SchemaVersion)
+	assert.Equal(t, safe.RecoveryTransactionConfirmationsType, payload.Type)
+	assert.Equal(t, "test_group", payload.Group)
+	assert.Equal(t, "0x123", payload.Subject)
+	assert.Equal(t, &safe.RecoveryTransactionConfirmationsData{
+		SafeAddress:           "0x123",
+		RecoveryTransactionID: "0xtx",
+		NumConfirmations:      1,
+		ExpectedConfirmations: 3,
+	}, payload.Data)
+}
@@ -3,6 +3,8 @@ package safe
 import (
 	"strings"
 	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
 )
 
 type RecoveryTransactionInvalid struct {
@@ -14,8 +16,16 @@ type RecoveryTransactionInvalid struct {
 	Reason                string
 }
 
+// RecoveryTransactionInvalidData is the payload data of a RecoveryTransactionInvalid event.
+type RecoveryTransactionInvalidData struct {
+	SafeAddress           string `json:"safeAddress"`
+	RecoveryTransactionID string `json:"recoveryTransactionId"`
+	Reason                string `json:"reason"`
+}
+
 const (
-	RecoveryTransactionInvalidType = "safe_recovery_transaction_invalid"
+	RecoveryTransactionInvalidType     = "safe_recovery_transaction_invalid"
+	RecoveryTransactionInvalidSeverity = event.SeverityCritical
 )
 
 func NewRecoveryTransactionInvalid(timestamp time.Time, monitor, group, safeAddress, txID, reason string) *RecoveryTransactionInvalid {
@@ -37,10 +47,26 @@ func (v *RecoveryTransactionInvalid) GetGroup() string {
 	return v.Group
 }
 
+func (v *RecoveryTransactionInvalid) GetSeverity() event.Severity {
+	return RecoveryTransactionInvalidSeverity
+}
+
+func (v *RecoveryTransactionInvalid) GetSubject() string {
+	return v.SafeAddress
+}
+
 func (v *RecoveryTransactionInvalid) GetMonitor() string {
 	return v.Monitor
 }
 
+func (v *RecoveryTransactionInvalid) GetPayload() *event.Payload {
+	return event.NewPayload(v, v.Timestamp, &RecoveryTransactionInvalidData{
+		SafeAddress:           v.SafeAddress,
+		RecoveryTransactionID: v.RecoveryTransactionID,
+		Reason:                v.Reason,
+	})
+}
+
 func (v *RecoveryTransactionInvalid) GetTitle(includeMonitor, includeGroup bool) string {
 	var sb strings.Builder
 
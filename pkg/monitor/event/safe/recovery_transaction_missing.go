@@ -3,6 +3,8 @@ package safe
 import (
 	"strings"
 	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
 )
 
 type RecoveryTransactionMissing struct {
@@ -12,8 +14,14 @@ type RecoveryTransactionMissing struct {
 	Monitor     string
 }
 
+// RecoveryTransactionMissingData is the payload data of a RecoveryTransactionMissing event.
+type RecoveryTransactionMissingData struct {
+	SafeAddress string `json:"safeAddress"`
+}
+
 const (
-	RecoveryTransactionMissingType = "safe_recovery_transaction_missing"
+	RecoveryTransactionMissingType     = "safe_recovery_transaction_missing"
+	RecoveryTransactionMissingSeverity = event.SeverityWarning
 )
 
 func NewRecoveryTransactionMissing(timestamp time.Time, monitor, group, safeAddress string) *RecoveryTransactionMissing {
@@ -33,10 +41,24 @@ func (v *RecoveryTransactionMissing) GetGroup() string {
 	return v.Group
 }
 
+func (v *RecoveryTransactionMissing) GetSeverity() event.Severity {
+	return RecoveryTransactionMissingSeverity
+}
+
+func (v *RecoveryTransactionMissing) GetSubject() string {
+	return v.SafeAddress
+}
+
 func (v *RecoveryTransactionMissing) GetMonitor() string {
 	return v.Monitor
 }
 
+func (v *RecoveryTransactionMissing) GetPayload() *event.Payload {
+	return event.NewPayload(v, v.Timestamp, &RecoveryTransactionMissingData{
+		SafeAddress: v.SafeAddress,
+	})
+}
+
 func (v *RecoveryTransactionMissing) GetTitle(includeMonitor, includeGroup bool) string {
 	var sb strings.Builder
 
@@ -3,6 +3,8 @@ package safe
 import (
 	"strings"
 	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
 )
 
 type RecoveryTransactionNotNext struct {
@@ -13,8 +15,15 @@ type RecoveryTransactionNotNext struct {
 	RecoveryTransactionID string
 }
 
+// RecoveryTransactionNotNextData is the payload data of a RecoveryTransactionNotNext event.
+type RecoveryTransactionNotNextData struct {
+	SafeAddress           string `json:"safeAddress"`
+	RecoveryTransactionID string `json:"recoveryTransactionId"`
+}
+
 const (
-	RecoveryTransactionNotNextType = "safe_recovery_transaction_not_next"
+	RecoveryTransactionNotNextType     = "safe_recovery_transaction_not_next"
+	RecoveryTransactionNotNextSeverity = event.SeverityWarning
 )
 
 func NewRecoveryTransactionNotNext(timestamp time.Time, monitor, group, safeAddress, recoveryTxID string) *RecoveryTransactionNotNext {
@@ -35,10 +44,25 @@ func (v *RecoveryTransactionNotNext) GetGroup() string {
 	return v.Group
 }
 
+func (v *RecoveryTransactionNotNext) GetSeverity() event.Severity {
+	return RecoveryTransactionNotNextSeverity
+}
+
+func (v *RecoveryTransactionNotNext) GetSubject() string {
+	return v.SafeAddress
+}
+
 func (v *RecoveryTransactionNotNext) GetMonitor() string {
 	return v.Monitor
 }
 
+func (v *RecoveryTransactionNotNext) GetPayload() *event.Payload {
+	return event.NewPayload(v, v.Timestamp, &RecoveryTransactionNotNextData{
+		SafeAddress:           v.SafeAddress,
+		RecoveryTransactionID: v.RecoveryTransactionID,
+	})
+}
+
 func (v *RecoveryTransactionNotNext) GetTitle(includeMonitor, includeGroup bool) string {
 	var sb strings.Builder
 
@@ -3,6 +3,8 @@ package safe
 import (
 	"strings"
 	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
 )
 
 type SignerMismatch struct {
@@ -12,8 +14,14 @@ type SignerMismatch struct {
 	SafeAddress string    `json:"address"`
 }
 
+// SignerMismatchData is the payload data of a SignerMismatch event.
+type SignerMismatchData struct {
+	SafeAddress string `json:"safeAddress"`
+}
+
 const (
-	SignerMismatchType = "signer_mismatch"
+	SignerMismatchType     = "signer_mismatch"
+	SignerMismatchSeverity = event.SeverityCritical
 )
 
 func NewSignerMismatch(timestamp time.Time, monitor, group, safeAddress string) *SignerMismatch {
@@ -33,10 +41,24 @@ func (v *SignerMismatch) GetGroup() string {
 	return "safe"
 }
 
+func (v *SignerMismatch) GetSeverity() event.Severity {
+	return SignerMismatchSeverity
+}
+
+func (v *SignerMismatch) GetSubject() string {
+	return v.SafeAddress
+}
+
 func (v *SignerMismatch) GetMonitor() string {
 	return v.Monitor
 }
 
+func (v *SignerMismatch) GetPayload() *event.Payload {
+	return event.NewPayload(v, v.Timestamp, &SignerMismatchData{
+		SafeAddress: v.SafeAddress,
+	})
+}
+
 func (v *SignerMismatch) GetTitle(includeMonitor, includeGroup bool) string {
 	var sb strings.Builder
 
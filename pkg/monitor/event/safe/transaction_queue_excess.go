@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strings"
 	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
 )
 
 type TransactionQueueExcess struct {
@@ -14,8 +16,15 @@ type TransactionQueueExcess struct {
 	NumTxs      int
 }
 
+// TransactionQueueExcessData is the payload data of a TransactionQueueExcess event.
+type TransactionQueueExcessData struct {
+	SafeAddress string `json:"safeAddress"`
+	NumTxs      int    `json:"numTxs"`
+}
+
 const (
-	TransactionQueueExcessType = "safe_transaction_queue_excess"
+	TransactionQueueExcessType     = "safe_transaction_queue_excess"
+	TransactionQueueExcessSeverity = event.SeverityWarning
 )
 
 func NewTransactionQueueExcess(timestamp time.Time, monitor, group, safeAddress string, numTxs int) *TransactionQueueExcess {
@@ -36,10 +45,25 @@ func (v *TransactionQueueExcess) GetGroup() string {
 	return v.Group
 }
 
+func (v *TransactionQueueExcess) GetSeverity() event.Severity {
+	return TransactionQueueExcessSeverity
+}
+
+func (v *TransactionQueueExcess) GetSubject() string {
+	return v.SafeAddress
+}
+
 func (v *TransactionQueueExcess) GetMonitor() string {
 	return v.Monitor
 }
 
+func (v *TransactionQueueExcess) GetPayload() *event.Payload {
+	return event.NewPayload(v, v.Timestamp, &TransactionQueueExcessData{
+		SafeAddress: v.SafeAddress,
+		NumTxs:      v.NumTxs,
+	})
+}
+
 func (v *TransactionQueueExcess) GetTitle(includeMonitor, includeGroup bool) string {
 	var sb strings.Builder
 
@@ -0,0 +1,43 @@
+package event
+
+import "fmt"
+
+// Severity is how urgent an event is, sources can be configured to only receive events
+// of a minimum severity.
+type Severity string
+
+const (
+	SeverityInfo     Severity = "info"
+	SeverityWarning  Severity = "warning"
+	SeverityCritical Severity = "critical"
+)
+
+// ParseSeverity returns the severity for a name.
+func ParseSeverity(name string) (Severity, error) {
+	severity := Severity(name)
+
+	if severity.Level() == 0 {
+		return "", fmt.Errorf("invalid severity %q, must be one of info, warning or critical", name)
+	}
+
+	return severity, nil
+}
+
+// Level returns the severity as a number that increases with urgency, unknown severities are 0.
+func (s Severity) Level() int {
+	switch s {
+	case SeverityInfo:
+		return 1
+	case SeverityWarning:
+		return 2
+	case SeverityCritical:
+		return 3
+	}
+
+	return 0
+}
+
+// AtLeast returns true if the severity is at least as urgent as min.
+func (s Severity) AtLeast(min Severity) bool {
+	return s.Level() >= min.Level()
+}
@@ -0,0 +1,63 @@
+package event_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/safe"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/split"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/validator"
+)
+
+func TestParseSeverity(t *testing.T) {
+	for _, name := range []string{"info", "warning", "critical"} {
+		severity, err := event.ParseSeverity(name)
+		assert.NoError(t, err)
+		assert.Equal(t, event.Severity(name), severity)
+	}
+
+	_, err := event.ParseSeverity("urgent")
+	assert.Error(t, err)
+
+	_, err = event.ParseSeverity("")
+	assert.Error(t, err)
+}
+
+func TestSeverityAtLeast(t *testing.T) {
+	assert.True(t, event.SeverityCritical.AtLeast(event.SeverityWarning))
+	assert.True(t, event.SeverityWarning.AtLeast(event.SeverityWarning))
+	assert.False(t, event.SeverityInfo.AtLeast(event.SeverityWarning))
+	assert.True(t, event.SeverityInfo.AtLeast(event.Severity("")))
+}
+
+func TestEventSeverity(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name  string
+		event event.Event
+		want  event.Severity
+	}{
+		{"controller", split.NewController(now, "m", "g", "0x1", "0x2", "0x3"), event.SeverityCritical},
+		{"hash unknown", split.NewHashUnknownState(now, "m", "g", "0x1", "a", "b"), event.SeverityCritical},
+		{"hash recovery", split.NewHashRecoveryState(now, "m", "g", "0x1", "a"), event.SeverityWarning},
+		{"hash initial", split.NewHashInitialState(now, "m", "g", "0x1", "a"), event.SeverityInfo},
+		{"signer mismatch", safe.NewSignerMismatch(now, "m", "g", "0x1"), event.SeverityCritical},
+		{"recovery tx invalid", safe.NewRecoveryTransactionInvalid(now, "m", "g", "0x1", "tx", "reason"), event.SeverityCritical},
+		{"recovery tx missing", safe.NewRecoveryTransactionMissing(now, "m", "g", "0x1"), event.SeverityWarning},
+		{"queue excess", safe.NewTransactionQueueExcess(now, "m", "g", "0x1", 3), event.SeverityWarning},
+		{"validator status", validator.NewStatus(now, "exited_unslashed", "0xabc", "g", "m"), event.SeverityWarning},
+		{"validator slashed", validator.NewStatus(now, "exited_slashed", "0xabc", "g", "m"), event.SeverityCritical},
+		{"min balance", validator.NewMinBalance(now, 1, "0xabc", "g", "m"), event.SeverityWarning},
+		{"resolved", event.NewResolved(now, now, "m", "g", split.HashUnknownStateType, split.HashUnknownStateSeverity, "title", "0x1"), event.SeverityCritical},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.event.GetSeverity())
+		})
+	}
+}
@@ -0,0 +1,320 @@
+package split
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+)
+
+// ContractEvent is published for every SplitMain log of a watched split, eg. an update of
+// the recipients or a control transfer.
+type ContractEvent struct {
+	Timestamp time.Time
+	// Name is the SplitMain event name, eg. UpdateSplit.
+	Name         string
+	SplitAddress string
+	TxHash       string
+	BlockNumber  uint64
+	Sender       string
+	// Controller is the new (potential) controller of control transfer events.
+	Controller         string
+	PreviousController string
+	// Account is the recipient that withdrew of withdrawal events.
+	Account     string
+	Distributor string
+	// Amount is the ETH amount in wei of distribute and withdrawal events.
+	Amount string
+	// Recipients are the recipients the split was updated to, nil if they could not be decoded.
+	Recipients     []*ContractEventRecipient
+	DistributorFee uint32
+	Group          string
+	Monitor        string
+}
+
+type ContractEventRecipient struct {
+	Address string `json:"address"`
+	// Allocation is the share of the recipient in millionths.
+	Allocation uint32 `json:"allocation"`
+}
+
+// ContractEventData is the payload data of a ContractEvent event.
+type ContractEventData struct {
+	Name               string                    `json:"name"`
+	SplitAddress       string                    `json:"splitAddress"`
+	TxHash             string                    `json:"txHash"`
+	BlockNumber        uint64                    `json:"blockNumber"`
+	Sender             string                    `json:"sender"`
+	Controller         string                    `json:"controller,omitempty"`
+	PreviousController string                    `json:"previousController,omitempty"`
+	Account            string                    `json:"account,omitempty"`
+	Distributor        string                    `json:"distributor,omitempty"`
+	Amount             string                    `json:"amount,omitempty"`
+	Recipients         []*ContractEventRecipient `json:"recipients,omitempty"`
+	DistributorFee     uint32                    `json:"distributorFee"`
+}
+
+const (
+	ContractEventUpdateSplitType             = "split_contract_update_split"
+	ContractEventInitiateControlTransferType = "split_contract_initiate_control_transfer"
+	ContractEventCancelControlTransferType   = "split_contract_cancel_control_transfer"
+	ContractEventControlTransferType         = "split_contract_control_transfer"
+	ContractEventDistributeETHType           = "split_contract_distribute_eth"
+	ContractEventWithdrawalType              = "split_contract_withdrawal"
+)
+
+var contractEvents = map[string]struct {
+	eventType string
+	severity  event.Severity
+	title     string
+}{
+	"UpdateSplit":             {ContractEventUpdateSplitType, event.SeverityWarning, "Split recipients updated"},
+	"InitiateControlTransfer": {ContractEventInitiateControlTransferType, event.SeverityCritical, "Split control transfer initiated"},
+	"CancelControlTransfer":   {ContractEventCancelControlTransferType, event.SeverityWarning, "Split control transfer cancelled"},
+	"ControlTransfer":         {ContractEventControlTransferType, event.SeverityCritical, "Split control transferred"},
+	"DistributeETH":           {ContractEventDistributeETHType, event.SeverityInfo, "Split ETH distributed"},
+	"Withdrawal":              {ContractEventWithdrawalType, event.SeverityInfo, "Split recipient withdrew"},
+}
+
+func NewContractEvent(timestamp time.Time, monitor, group, name, splitAddress, txHash string, blockNumber uint64, sender string) *ContractEvent {
+	return &ContractEvent{
+		Timestamp:    timestamp,
+		Name:         name,
+		SplitAddress: splitAddress,
+		TxHash:       txHash,
+		BlockNumber:  blockNumber,
+		Sender:       sender,
+		Group:        group,
+		Monitor:      monitor,
+	}
+}
+
+func (v *ContractEvent) GetType() string {
+	if e, ok := contractEvents[v.Name]; ok {
+		return e.eventType
+	}
+
+	return "split_contract_" + strings.ToLower(v.Name)
+}
+
+func (v *ContractEvent) GetGroup() string {
+	return v.Group
+}
+
+func (v *ContractEvent) GetSeverity() event.Severity {
+	if e, ok := contractEvents[v.Name]; ok {
+		return e.severity
+	}
+
+	return event.SeverityInfo
+}
+
+// IsNotice returns true, contract logs are never resolved.
+func (v *ContractEvent) IsNotice() bool {
+	return true
+}
+
+func (v *ContractEvent) GetSubject() string {
+	return v.SplitAddress
+}
+
+func (v *ContractEvent) GetMonitor() string {
+	return v.Monitor
+}
+
+func (v *ContractEvent) GetPayload() *event.Payload {
+	return event.NewPayload(v, v.Timestamp, &ContractEventData{
+		Name:               v.Name,
+		SplitAddress:       v.SplitAddress,
+		TxHash:             v.TxHash,
+		BlockNumber:        v.BlockNumber,
+		Sender:             v.Sender,
+		Controller:         v.Controller,
+		PreviousController: v.PreviousController,
+		Account:            v.Account,
+		Distributor:        v.Distributor,
+		Amount:             v.Amount,
+		Recipients:         v.Recipients,
+		DistributorFee:     v.DistributorFee,
+	})
+}
+
+func (v *ContractEvent) GetTitle(includeMonitor, includeGroup bool) string {
+	var sb strings.Builder
+
+	if includeMonitor {
+		sb.WriteString("[")
+		sb.WriteString(v.Monitor)
+		sb.WriteString("] ")
+	}
+
+	if e, ok := contractEvents[v.Name]; ok {
+		sb.WriteString(e.title)
+	} else {
+		sb.WriteString("Split contract event ")
+		sb.WriteString(v.Name)
+	}
+
+	return sb.String()
+}
+
+// fields returns the event specific fields in display order.
+func (v *ContractEvent) fields() [][2]string {
+	fields := [][2]string{
+		{"Split Address", v.SplitAddress},
+		{"Event", v.Name},
+		{"Transaction", v.TxHash},
+		{"Block", fmt.Sprintf("%d", v.BlockNumber)},
+		{"Sender", v.Sender},
+	}
+
+	if v.PreviousController != "" {
+		fields = append(fields, [2]string{"Previous Controller", v.PreviousController})
+	}
+
+	if v.Controller != "" {
+		fields = append(fields, [2]string{"New Controller", v.Controller})
+	}
+
+	if v.Account != "" {
+		fields = append(fields, [2]string{"Account", v.Account})
+	}
+
+	if v.Distributor != "" {
+		fields = append(fields, [2]string{"Distributor", v.Distributor})
+	}
+
+	if v.Amount != "" {
+		fields = append(fields, [2]string{"Amount (wei)", v.Amount})
+	}
+
+	if v.Recipients != nil {
+		fields = append(fields, [2]string{"Distributor Fee", formatAllocation(v.DistributorFee)})
+	}
+
+	return fields
+}
+
+func formatAllocation(allocation uint32) string {
+	return fmt.Sprintf("%.4f%%", float64(allocation)/1e4)
+}
+
+func (v *ContractEvent) GetDescriptionText(includeMonitor, includeGroup bool) string {
+	var sb strings.Builder
+
+	sb.WriteString("\nTimestamp: ")
+	sb.WriteString(v.Timestamp.UTC().Format("2006-01-02 15:04:05 UTC"))
+
+	if includeMonitor {
+		sb.WriteString("\nMonitor: ")
+		sb.WriteString(v.Monitor)
+	}
+
+	if includeGroup {
+		sb.WriteString("\nGroup: ")
+		sb.WriteString(v.Group)
+	}
+
+	for _, f := range v.fields() {
+		sb.WriteString("\n")
+		sb.WriteString(f[0])
+		sb.WriteString(": ")
+		sb.WriteString(f[1])
+	}
+
+	if v.Recipients != nil {
+		sb.WriteString("\nNew Recipients:")
+
+		for _, r := range v.Recipients {
+			sb.WriteString("\n  ")
+			sb.WriteString(r.Address)
+			sb.WriteString(" ")
+			sb.WriteString(formatAllocation(r.Allocation))
+		}
+	}
+
+	return sb.String()
+}
+
+func (v *ContractEvent) GetDescriptionMarkdown(includeMonitor, includeGroup bool) string {
+	var sb strings.Builder
+
+	sb.WriteString("**Timestamp:** ")
+	sb.WriteString(v.Timestamp.UTC().Format("2006-01-02 15:04:05 UTC"))
+
+	if includeMonitor {
+		sb.WriteString("\n**Monitor:** ")
+		sb.WriteString(v.Monitor)
+	}
+
+	if includeGroup {
+		sb.WriteString("\n**Group:** ")
+		sb.WriteString(v.Group)
+	}
+
+	for _, f := range v.fields() {
+		sb.WriteString("\n**")
+		sb.WriteString(f[0])
+		sb.WriteString(":** `")
+		sb.WriteString(f[1])
+		sb.WriteString("`")
+	}
+
+	if v.Recipients != nil {
+		sb.WriteString("\n**New Recipients:**")
+
+		for _, r := range v.Recipients {
+			sb.WriteString("\n- `")
+			sb.WriteString(r.Address)
+			sb.WriteString("` ")
+			sb.WriteString(formatAllocation(r.Allocation))
+		}
+	}
+
+	return sb.String()
+}
+
+func (v *ContractEvent) GetDescriptionHTML(includeMonitor, includeGroup bool) string {
+	var sb strings.Builder
+
+	sb.WriteString("<p><strong>Timestamp:</strong> ")
+	sb.WriteString(v.Timestamp.UTC().Format("2006-01-02 15:04:05 UTC"))
+	sb.WriteString("</p>")
+
+	if includeMonitor {
+		sb.WriteString("<p><strong>Monitor:</strong> ")
+		sb.WriteString(v.Monitor)
+		sb.WriteString("</p>")
+	}
+
+	if includeGroup {
+		sb.WriteString("<p><strong>Group:</strong> ")
+		sb.WriteString(v.Group)
+		sb.WriteString("</p>")
+	}
+
+	for _, f := range v.fields() {
+		sb.WriteString("<p><strong>")
+		sb.WriteString(f[0])
+		sb.WriteString(":</strong> ")
+		sb.WriteString(f[1])
+		sb.WriteString("</p>")
+	}
+
+	if v.Recipients != nil {
+		sb.WriteString("<p><strong>New Recipients:</strong></p><ul>")
+
+		for _, r := range v.Recipients {
+			sb.WriteString("<li>")
+			sb.WriteString(r.Address)
+			sb.WriteString(" ")
+			sb.WriteString(formatAllocation(r.Allocation))
+			sb.WriteString("</li>")
+		}
+
+		sb.WriteString("</ul>")
+	}
+
+	return sb.String()
+}
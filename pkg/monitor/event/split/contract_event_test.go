@@ -0,0 +1,115 @@
+package split_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/split"
+)
+
+func TestContractEvent(t *testing.T) {
+	timestamp := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	update := split.NewContractEvent(timestamp, "test_monitor", "test_group", "UpdateSplit", "0x123", "0xtx", 100, "0xsender")
+	update.DistributorFee = 0
+	update.Recipients = []*split.ContractEventRecipient{
+		{Address: "0x456", Allocation: 1},
+		{Address: "0x789", Allocation: 999999},
+	}
+
+	transfer := split.NewContractEvent(timestamp, "test_monitor", "test_group", "ControlTransfer", "0x123", "0xtx", 100, "0xsender")
+	transfer.PreviousController = "0xold"
+	transfer.Controller = "0xnew"
+
+	tests := []struct {
+		name         string
+		event        *split.ContractEvent
+		wantType     string
+		wantSeverity event.Severity
+		wantTitle    string
+		wantDesc     string
+		wantMarkdown string
+	}{
+		{
+			name:         "update split",
+			event:        update,
+			wantType:     split.ContractEventUpdateSplitType,
+			wantSeverity: event.SeverityWarning,
+			wantTitle:    "[test_monitor] Split recipients updated",
+			wantDesc: `
+Timestamp: 2024-01-01 12:00:00 UTC
+Monitor: test_monitor
+Group: test_group
+Split Address: 0x123
+Event: UpdateSplit
+Transaction: 0xtx
+Block: 100
+Sender: 0xsender
+Distributor Fee: 0.0000%
+New Recipients:
+  0x456 0.0001%
+  0x789 99.9999%`,
+			wantMarkdown: "**Timestamp:** 2024-01-01 12:00:00 UTC\n**Monitor:** test_monitor\n**Group:** test_group\n" +
+				"**Split Address:** `0x123`\n**Event:** `UpdateSplit`\n**Transaction:** `0xtx`\n**Block:** `100`\n**Sender:** `0xsender`\n" +
+				"**Distributor Fee:** `0.0000%`\n**New Recipients:**\n- `0x456` 0.0001%\n- `0x789` 99.9999%",
+		},
+		{
+			name:         "control transfer",
+			event:        transfer,
+			wantType:     split.ContractEventControlTransferType,
+			wantSeverity: event.SeverityCritical,
+			wantTitle:    "[test_monitor] Split control transferred",
+			wantDesc: `
+Timestamp: 2024-01-01 12:00:00 UTC
+Monitor: test_monitor
+Group: test_group
+Split Address: 0x123
+Event: ControlTransfer
+Transaction: 0xtx
+Block: 100
+Sender: 0xsender
+Previous Controller: 0xold
+New Controller: 0xnew`,
+			wantMarkdown: "**Timestamp:** 2024-01-01 12:00:00 UTC\n**Monitor:** test_monitor\n**Group:** test_group\n" +
+				"**Split Address:** `0x123`\n**Event:** `ControlTransfer`\n**Transaction:** `0xtx`\n**Block:** `100`\n**Sender:** `0xsender`\n" +
+				"**Previous Controller:** `0xold`\n**New Controller:** `0xnew`",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantType, tt.event.GetType())
+			assert.Equal(t, tt.wantSeverity, tt.event.GetSeverity())
+			assert.Equal(t, "test_group", tt.event.GetGroup())
+			assert.Equal(t, "test_monitor", tt.event.GetMonitor())
+			assert.Equal(t, "0x123", event.GetSubject(tt.event))
+			assert.True(t, event.IsNotice(tt.event))
+			assert.Equal(t, tt.wantTitle, tt.event.GetTitle(true, true))
+			assert.Equal(t, tt.wantDesc, tt.event.GetDescriptionText(true, true))
+			assert.Equal(t, tt.wantMarkdown, tt.event.GetDescriptionMarkdown(true, true))
+			assert.Contains(t, tt.event.GetDescriptionHTML(true, true), "<p><strong>Transaction:</strong> 0xtx</p>")
+		})
+	}
+}
+
+func TestContractEventPayload(t *testing.T) {
+	e := split.NewContractEvent(time.Now(), "test_monitor", "test_group", "Withdrawal", "0x123", "0xtx", 100, "0xsender")
+	e.Account = "0x456"
+	e.Amount = "1000000000000000000"
+
+	payload := e.GetPayload()
+
+	assert.Equal(t, split.ContractEventWithdrawalType, payload.Type)
+	assert.Equal(t, &split.ContractEventData{
+		Name:         "Withdrawal",
+		SplitAddress: "0x123",
+		TxHash:       "0xtx",
+		BlockNumber:  100,
+		Sender:       "0xsender",
+		Account:      "0x456",
+		Amount:       "1000000000000000000",
+	}, payload.Data)
+}
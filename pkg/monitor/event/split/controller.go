@@ -3,6 +3,8 @@ package split
 import (
 	"strings"
 	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
 )
 
 type Controller struct {
@@ -14,8 +16,16 @@ type Controller struct {
 	Monitor            string
 }
 
+// ControllerData is the payload data of a Controller event.
+type ControllerData struct {
+	SplitAddress       string `json:"splitAddress"`
+	ExpectedController string `json:"expectedController"`
+	ActualController   string `json:"actualController"`
+}
+
 const (
-	ControllerType = "split_controller"
+	ControllerType     = "split_controller"
+	ControllerSeverity = event.SeverityCritical
 )
 
 func NewController(timestamp time.Time, monitor, group, splitAddress, expectedController, actualController string) *Controller {
@@ -37,10 +47,26 @@ func (v *Controller) GetGroup() string {
 	return v.Group
 }
 
+func (v *Controller) GetSeverity() event.Severity {
+	return ControllerSeverity
+}
+
+func (v *Controller) GetSubject() string {
+	return v.SplitAddress
+}
+
 func (v *Controller) GetMonitor() string {
 	return v.Monitor
 }
 
+func (v *Controller) GetPayload() *event.Payload {
+	return event.NewPayload(v, v.Timestamp, &ControllerData{
+		SplitAddress:       v.SplitAddress,
+		ExpectedController: v.ExpectedController,
+		ActualController:   v.ActualController,
+	})
+}
+
 func (v *Controller) GetTitle(includeMonitor, includeGroup bool) string {
 	var sb strings.Builder
 
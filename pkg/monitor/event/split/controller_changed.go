@@ -0,0 +1,216 @@
+package split
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+)
+
+// ControllerChanged is published when the controller of the split changes from one address
+// to another, unlike Controller which alerts while the controller isn't the expected one.
+type ControllerChanged struct {
+	Timestamp          time.Time
+	SplitAddress       string
+	ExpectedController string
+	PreviousController string
+	Controller         string
+	Group              string
+	Monitor            string
+}
+
+// ControllerChangedData is the payload data of a ControllerChanged event.
+type ControllerChangedData struct {
+	SplitAddress       string `json:"splitAddress"`
+	ExpectedController string `json:"expectedController"`
+	PreviousController string `json:"previousController"`
+	Controller         string `json:"controller"`
+	Message            string `json:"message"`
+}
+
+const (
+	ControllerChangedType = "split_controller_changed"
+	// ControllerChangedSeverity is the severity of a change to an unexpected controller, a
+	// change back to the expected controller is info.
+	ControllerChangedSeverity = event.SeverityCritical
+)
+
+func NewControllerChanged(timestamp time.Time, monitor, group, splitAddress, expectedController, previousController, controller string) *ControllerChanged {
+	return &ControllerChanged{
+		Timestamp:          timestamp,
+		SplitAddress:       splitAddress,
+		ExpectedController: expectedController,
+		PreviousController: previousController,
+		Controller:         controller,
+		Group:              group,
+		Monitor:            monitor,
+	}
+}
+
+func (v *ControllerChanged) GetType() string {
+	return ControllerChangedType
+}
+
+func (v *ControllerChanged) GetGroup() string {
+	return v.Group
+}
+
+func (v *ControllerChanged) GetSeverity() event.Severity {
+	if strings.EqualFold(v.Controller, v.ExpectedController) {
+		return event.SeverityInfo
+	}
+
+	return ControllerChangedSeverity
+}
+
+// IsNotice returns true, the change is covered by the controller alert which is resolved.
+func (v *ControllerChanged) IsNotice() bool {
+	return true
+}
+
+func (v *ControllerChanged) GetSubject() string {
+	return v.SplitAddress
+}
+
+func (v *ControllerChanged) GetMonitor() string {
+	return v.Monitor
+}
+
+// Message explains what the change means for the recovery of the split.
+func (v *ControllerChanged) Message() string {
+	switch {
+	case strings.EqualFold(v.Controller, ZeroAddress):
+		return ImmutableMessage
+	case strings.EqualFold(v.Controller, v.ExpectedController):
+		return "The split controller is the expected controller again."
+	default:
+		return "The split controller is not the expected controller, the split can only be moved to the recovery state by the new controller."
+	}
+}
+
+func (v *ControllerChanged) GetPayload() *event.Payload {
+	return event.NewPayload(v, v.Timestamp, &ControllerChangedData{
+		SplitAddress:       v.SplitAddress,
+		ExpectedController: v.ExpectedController,
+		PreviousController: v.PreviousController,
+		Controller:         v.Controller,
+		Message:            v.Message(),
+	})
+}
+
+func (v *ControllerChanged) GetTitle(includeMonitor, includeGroup bool) string {
+	var sb strings.Builder
+
+	if includeMonitor {
+		sb.WriteString("[")
+		sb.WriteString(v.Monitor)
+		sb.WriteString("] ")
+	}
+
+	sb.WriteString("Split controller changed")
+
+	return sb.String()
+}
+
+// fields returns the event specific fields in display order.
+func (v *ControllerChanged) fields() [][2]string {
+	return [][2]string{
+		{"Split Address", v.SplitAddress},
+		{"Previous Controller address", v.PreviousController},
+		{"New Controller address", v.Controller},
+		{"Expected Controller address", v.ExpectedController},
+	}
+}
+
+func (v *ControllerChanged) GetDescriptionText(includeMonitor, includeGroup bool) string {
+	var sb strings.Builder
+
+	sb.WriteString("\nTimestamp: ")
+	sb.WriteString(v.Timestamp.UTC().Format("2006-01-02 15:04:05 UTC"))
+
+	if includeMonitor {
+		sb.WriteString("\nMonitor: ")
+		sb.WriteString(v.Monitor)
+	}
+
+	if includeGroup {
+		sb.WriteString("\nGroup: ")
+		sb.WriteString(v.Group)
+	}
+
+	for _, f := range v.fields() {
+		sb.WriteString("\n")
+		sb.WriteString(f[0])
+		sb.WriteString(": ")
+		sb.WriteString(f[1])
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString(v.Message())
+
+	return sb.String()
+}
+
+func (v *ControllerChanged) GetDescriptionMarkdown(includeMonitor, includeGroup bool) string {
+	var sb strings.Builder
+
+	sb.WriteString("**Timestamp:** ")
+	sb.WriteString(v.Timestamp.UTC().Format("2006-01-02 15:04:05 UTC"))
+
+	if includeMonitor {
+		sb.WriteString("\n**Monitor:** ")
+		sb.WriteString(v.Monitor)
+	}
+
+	if includeGroup {
+		sb.WriteString("\n**Group:** ")
+		sb.WriteString(v.Group)
+	}
+
+	for _, f := range v.fields() {
+		sb.WriteString("\n**")
+		sb.WriteString(f[0])
+		sb.WriteString(":** `")
+		sb.WriteString(f[1])
+		sb.WriteString("`")
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString(v.Message())
+
+	return sb.String()
+}
+
+func (v *ControllerChanged) GetDescriptionHTML(includeMonitor, includeGroup bool) string {
+	var sb strings.Builder
+
+	sb.WriteString("<p><strong>Timestamp:</strong> ")
+	sb.WriteString(v.Timestamp.UTC().Format("2006-01-02 15:04:05 UTC"))
+	sb.WriteString("</p>")
+
+	if includeMonitor {
+		sb.WriteString("<p><strong>Monitor:</strong> ")
+		sb.WriteString(v.Monitor)
+		sb.WriteString("</p>")
+	}
+
+	if includeGroup {
+		sb.WriteString("<p><strong>Group:</strong> ")
+		sb.WriteString(v.Group)
+		sb.WriteString("</p>")
+	}
+
+	for _, f := range v.fields() {
+		sb.WriteString("<p><strong>")
+		sb.WriteString(f[0])
+		sb.WriteString(":</strong> ")
+		sb.WriteString(f[1])
+		sb.WriteString("</p>")
+	}
+
+	sb.WriteString("<p>")
+	sb.WriteString(v.Message())
+	sb.WriteString("</p>")
+
+	return sb.String()
+}
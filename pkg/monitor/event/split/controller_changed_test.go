@@ -0,0 +1,68 @@
+package split_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/split"
+)
+
+func TestControllerChanged(t *testing.T) {
+	timestamp := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		controller   string
+		wantSeverity event.Severity
+		wantMessage  string
+	}{
+		{
+			name:         "unexpected controller",
+			controller:   "0x789",
+			wantSeverity: event.SeverityCritical,
+			wantMessage:  "The split controller is not the expected controller, the split can only be moved to the recovery state by the new controller.",
+		},
+		{
+			name:         "expected controller",
+			controller:   "0x456",
+			wantSeverity: event.SeverityInfo,
+			wantMessage:  "The split controller is the expected controller again.",
+		},
+		{
+			name:         "immutable",
+			controller:   "0x0000000000000000000000000000000000000000",
+			wantSeverity: event.SeverityCritical,
+			wantMessage:  split.ImmutableMessage,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := split.NewControllerChanged(timestamp, "test_monitor", "test_group", "0x123", "0x456", "0xabc", tt.controller)
+
+			assert.Equal(t, split.ControllerChangedType, e.GetType())
+			assert.Equal(t, tt.wantSeverity, e.GetSeverity())
+			assert.True(t, event.IsNotice(e))
+			assert.Equal(t, "[test_monitor] Split controller changed", e.GetTitle(true, true))
+			assert.Equal(t, `
+Timestamp: 2024-01-01 12:00:00 UTC
+Split Address: 0x123
+Previous Controller address: 0xabc
+New Controller address: `+tt.controller+`
+Expected Controller address: 0x456
+`+tt.wantMessage, e.GetDescriptionText(false, false))
+			assert.Contains(t, e.GetDescriptionMarkdown(false, false), "**New Controller address:** `"+tt.controller+"`")
+			assert.Contains(t, e.GetDescriptionHTML(false, false), "<p>"+tt.wantMessage+"</p>")
+			assert.Equal(t, &split.ControllerChangedData{
+				SplitAddress:       "0x123",
+				ExpectedController: "0x456",
+				PreviousController: "0xabc",
+				Controller:         tt.controller,
+				Message:            tt.wantMessage,
+			}, e.GetPayload().Data)
+		})
+	}
+}
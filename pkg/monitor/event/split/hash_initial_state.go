@@ -3,6 +3,8 @@ package split
 import (
 	"strings"
 	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
 )
 
 type HashInitialState struct {
@@ -13,8 +15,15 @@ type HashInitialState struct {
 	Monitor      string
 }
 
+// HashInitialStateData is the payload data of a HashInitialState event.
+type HashInitialStateData struct {
+	SplitAddress string `json:"splitAddress"`
+	Hash         string `json:"hash"`
+}
+
 const (
-	HashInitialStateType = "split_hash_initial_state"
+	HashInitialStateType     = "split_hash_initial_state"
+	HashInitialStateSeverity = event.SeverityInfo
 )
 
 func NewHashInitialState(timestamp time.Time, monitor, group, splitAddress, hash string) *HashInitialState {
@@ -35,10 +44,25 @@ func (v *HashInitialState) GetGroup() string {
 	return v.Group
 }
 
+func (v *HashInitialState) GetSeverity() event.Severity {
+	return HashInitialStateSeverity
+}
+
+func (v *HashInitialState) GetSubject() string {
+	return v.SplitAddress
+}
+
 func (v *HashInitialState) GetMonitor() string {
 	return v.Monitor
 }
 
+func (v *HashInitialState) GetPayload() *event.Payload {
+	return event.NewPayload(v, v.Timestamp, &HashInitialStateData{
+		SplitAddress: v.SplitAddress,
+		Hash:         v.Hash,
+	})
+}
+
 func (v *HashInitialState) GetTitle(includeMonitor, includeGroup bool) string {
 	var sb strings.Builder
 
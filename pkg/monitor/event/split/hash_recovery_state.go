@@ -3,6 +3,8 @@ package split
 import (
 	"strings"
 	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
 )
 
 type HashRecoveryState struct {
@@ -13,8 +15,15 @@ type HashRecoveryState struct {
 	Monitor      string
 }
 
+// HashRecoveryStateData is the payload data of a HashRecoveryState event.
+type HashRecoveryStateData struct {
+	SplitAddress string `json:"splitAddress"`
+	Hash         string `json:"hash"`
+}
+
 const (
-	HashRecoveryStateType = "split_hash_recovery_state"
+	HashRecoveryStateType     = "split_hash_recovery_state"
+	HashRecoveryStateSeverity = event.SeverityWarning
 )
 
 func NewHashRecoveryState(timestamp time.Time, monitor, group, splitAddress, hash string) *HashRecoveryState {
@@ -35,10 +44,25 @@ func (v *HashRecoveryState) GetGroup() string {
 	return v.Group
 }
 
+func (v *HashRecoveryState) GetSeverity() event.Severity {
+	return HashRecoveryStateSeverity
+}
+
+func (v *HashRecoveryState) GetSubject() string {
+	return v.SplitAddress
+}
+
 func (v *HashRecoveryState) GetMonitor() string {
 	return v.Monitor
 }
 
+func (v *HashRecoveryState) GetPayload() *event.Payload {
+	return event.NewPayload(v, v.Timestamp, &HashRecoveryStateData{
+		SplitAddress: v.SplitAddress,
+		Hash:         v.Hash,
+	})
+}
+
 func (v *HashRecoveryState) GetTitle(includeMonitor, includeGroup bool) string {
 	var sb strings.Builder
 
@@ -1,8 +1,11 @@
 package split
 
 import (
+	"fmt"
 	"strings"
 	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
 )
 
 type HashUnknownState struct {
@@ -10,12 +13,54 @@ type HashUnknownState struct {
 	SplitAddress string
 	ExpectedHash string
 	ActualHash   string
-	Group        string
-	Monitor      string
+	// Recipients are the current recipients of the split reconstructed from the transaction
+	// that set them, nil if they could not be found.
+	Recipients       []*ContractEventRecipient
+	DistributorFee   uint32
+	RecipientsTxHash string
+	// Changes are the differences from the configured accounts to the current recipients.
+	Changes []*RecipientChange
+	Group   string
+	Monitor string
+}
+
+// RecipientChange is a difference between the configured accounts and the recipients of a split.
+type RecipientChange struct {
+	// Change is added, removed or changed.
+	Change  string `json:"change"`
+	Address string `json:"address"`
+	// ExpectedAllocation is the configured allocation in millionths, zero for added recipients.
+	ExpectedAllocation uint32 `json:"expectedAllocation"`
+	// ActualAllocation is the current allocation in millionths, zero for removed recipients.
+	ActualAllocation uint32 `json:"actualAllocation"`
+}
+
+func (c *RecipientChange) String() string {
+	switch c.Change {
+	case "added":
+		return fmt.Sprintf("added %s %s", c.Address, formatAllocation(c.ActualAllocation))
+	case "removed":
+		return fmt.Sprintf("removed %s %s", c.Address, formatAllocation(c.ExpectedAllocation))
+	default:
+		return fmt.Sprintf("%s %s %s -> %s", c.Change, c.Address, formatAllocation(c.ExpectedAllocation), formatAllocation(c.ActualAllocation))
+	}
+}
+
+// HashUnknownStateData is the payload data of a HashUnknownState event.
+type HashUnknownStateData struct {
+	SplitAddress string `json:"splitAddress"`
+	ExpectedHash string `json:"expectedHash"`
+	ActualHash   string `json:"actualHash"`
+	// Recipients are omitted if they could not be reconstructed.
+	Recipients       []*ContractEventRecipient `json:"recipients,omitempty"`
+	DistributorFee   uint32                    `json:"distributorFee"`
+	RecipientsTxHash string                    `json:"recipientsTxHash,omitempty"`
+	Changes          []*RecipientChange        `json:"changes,omitempty"`
 }
 
 const (
-	HashUnknownStateType = "split_hash_unknown_state"
+	HashUnknownStateType     = "split_hash_unknown_state"
+	HashUnknownStateSeverity = event.SeverityCritical
 )
 
 func NewHashUnknownState(timestamp time.Time, monitor, group, splitAddress, expectedHash, actualHash string) *HashUnknownState {
@@ -37,10 +82,31 @@ func (v *HashUnknownState) GetGroup() string {
 	return v.Group
 }
 
+func (v *HashUnknownState) GetSeverity() event.Severity {
+	return HashUnknownStateSeverity
+}
+
+func (v *HashUnknownState) GetSubject() string {
+	return v.SplitAddress
+}
+
 func (v *HashUnknownState) GetMonitor() string {
 	return v.Monitor
 }
 
+func (v *HashUnknownState) GetPayload() *event.Payload {
+	return event.NewPayload(v, v.Timestamp, &HashUnknownStateData{
+		SplitAddress: v.SplitAddress,
+		ExpectedHash: v.ExpectedHash,
+		ActualHash:   v.ActualHash,
+
+		Recipients:       v.Recipients,
+		DistributorFee:   v.DistributorFee,
+		RecipientsTxHash: v.RecipientsTxHash,
+		Changes:          v.Changes,
+	})
+}
+
 func (v *HashUnknownState) GetTitle(includeMonitor, includeGroup bool) string {
 	var sb strings.Builder
 
@@ -78,6 +144,28 @@ func (v *HashUnknownState) GetDescriptionText(includeMonitor, includeGroup bool)
 	sb.WriteString("\nActual Hash: ")
 	sb.WriteString(v.ActualHash)
 
+	if v.Recipients != nil {
+		sb.WriteString("\nRecipients Transaction: ")
+		sb.WriteString(v.RecipientsTxHash)
+		sb.WriteString("\nDistributor Fee: ")
+		sb.WriteString(formatAllocation(v.DistributorFee))
+		sb.WriteString("\nChanges:")
+
+		for _, c := range v.Changes {
+			sb.WriteString("\n  ")
+			sb.WriteString(c.String())
+		}
+
+		sb.WriteString("\nActual Recipients:")
+
+		for _, r := range v.Recipients {
+			sb.WriteString("\n  ")
+			sb.WriteString(r.Address)
+			sb.WriteString(" ")
+			sb.WriteString(formatAllocation(r.Allocation))
+		}
+	}
+
 	return sb.String()
 }
 
@@ -112,6 +200,33 @@ func (v *HashUnknownState) GetDescriptionMarkdown(includeMonitor, includeGroup b
 	sb.WriteString(v.ActualHash)
 	sb.WriteString("`")
 
+	if v.Recipients != nil {
+		sb.WriteString("\n**Recipients Transaction:** `")
+		sb.WriteString(v.RecipientsTxHash)
+		sb.WriteString("`\n")
+
+		sb.WriteString("**Distributor Fee:** `")
+		sb.WriteString(formatAllocation(v.DistributorFee))
+		sb.WriteString("`\n")
+
+		sb.WriteString("**Changes:**")
+
+		for _, c := range v.Changes {
+			sb.WriteString("\n- `")
+			sb.WriteString(c.String())
+			sb.WriteString("`")
+		}
+
+		sb.WriteString("\n**Actual Recipients:**")
+
+		for _, r := range v.Recipients {
+			sb.WriteString("\n- `")
+			sb.WriteString(r.Address)
+			sb.WriteString("` ")
+			sb.WriteString(formatAllocation(r.Allocation))
+		}
+	}
+
 	return sb.String()
 }
 
@@ -146,5 +261,35 @@ func (v *HashUnknownState) GetDescriptionHTML(includeMonitor, includeGroup bool)
 	sb.WriteString(v.ActualHash)
 	sb.WriteString("</p>")
 
+	if v.Recipients != nil {
+		sb.WriteString("<p><strong>Recipients Transaction:</strong> ")
+		sb.WriteString(v.RecipientsTxHash)
+		sb.WriteString("</p>")
+
+		sb.WriteString("<p><strong>Distributor Fee:</strong> ")
+		sb.WriteString(formatAllocation(v.DistributorFee))
+		sb.WriteString("</p>")
+
+		sb.WriteString("<p><strong>Changes:</strong></p><ul>")
+
+		for _, c := range v.Changes {
+			sb.WriteString("<li>")
+			sb.WriteString(c.String())
+			sb.WriteString("</li>")
+		}
+
+		sb.WriteString("</ul><p><strong>Actual Recipients:</strong></p><ul>")
+
+		for _, r := range v.Recipients {
+			sb.WriteString("<li>")
+			sb.WriteString(r.Address)
+			sb.WriteString(" ")
+			sb.WriteString(formatAllocation(r.Allocation))
+			sb.WriteString("</li>")
+		}
+
+		sb.WriteString("</ul>")
+	}
+
 	return sb.String()
 }
@@ -175,3 +175,48 @@ Actual Hash: 0x789`,
 		})
 	}
 }
+
+func TestHashUnknownStateRecipients(t *testing.T) {
+	evt := split.NewHashUnknownState(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC), "test_monitor", "test_group", "0x123", "0x456", "0x789")
+	evt.RecipientsTxHash = "0xtx"
+	evt.DistributorFee = 100
+	evt.Recipients = []*split.ContractEventRecipient{
+		{Address: "0xaaa", Allocation: 600000},
+		{Address: "0xccc", Allocation: 400000},
+	}
+	evt.Changes = []*split.RecipientChange{
+		{Change: "changed", Address: "0xaaa", ExpectedAllocation: 500000, ActualAllocation: 600000},
+		{Change: "removed", Address: "0xbbb", ExpectedAllocation: 500000},
+		{Change: "added", Address: "0xccc", ActualAllocation: 400000},
+	}
+
+	assert.Equal(t, `
+Timestamp: 2024-01-01 12:00:00 UTC
+Split Address: 0x123
+Expected Hash: 0x456
+Actual Hash: 0x789
+Recipients Transaction: 0xtx
+Distributor Fee: 0.0100%
+Changes:
+  changed 0xaaa 50.0000% -> 60.0000%
+  removed 0xbbb 50.0000%
+  added 0xccc 40.0000%
+Actual Recipients:
+  0xaaa 60.0000%
+  0xccc 40.0000%`, evt.GetDescriptionText(false, false))
+
+	assert.Contains(t, evt.GetDescriptionMarkdown(false, false), "\n**Changes:**\n- `changed 0xaaa 50.0000% -> 60.0000%`")
+	assert.Contains(t, evt.GetDescriptionHTML(false, false), "<li>removed 0xbbb 50.0000%</li>")
+
+	payload := evt.GetPayload()
+
+	assert.Equal(t, &split.HashUnknownStateData{
+		SplitAddress:     "0x123",
+		ExpectedHash:     "0x456",
+		ActualHash:       "0x789",
+		Recipients:       evt.Recipients,
+		DistributorFee:   100,
+		RecipientsTxHash: "0xtx",
+		Changes:          evt.Changes,
+	}, payload.Data)
+}
@@ -0,0 +1,179 @@
+package split
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+)
+
+// Immutable is published when the controller of the split is the zero address, eg. after
+// makeSplitImmutable. The split can never be updated again so it can't be recovered.
+type Immutable struct {
+	Timestamp          time.Time
+	SplitAddress       string
+	ExpectedController string
+	Group              string
+	Monitor            string
+}
+
+// ImmutableData is the payload data of an Immutable event.
+type ImmutableData struct {
+	SplitAddress       string `json:"splitAddress"`
+	ExpectedController string `json:"expectedController"`
+	Message            string `json:"message"`
+}
+
+// ZeroAddress is the controller of an immutable split, and the potential controller of a
+// split without a pending control transfer.
+const ZeroAddress = "0x0000000000000000000000000000000000000000"
+
+const (
+	ImmutableType     = "split_immutable"
+	ImmutableSeverity = event.SeverityCritical
+	ImmutableMessage  = "The split controller is the zero address, the split can never be updated again so it can not be moved to the recovery state."
+)
+
+func NewImmutable(timestamp time.Time, monitor, group, splitAddress, expectedController string) *Immutable {
+	return &Immutable{
+		Timestamp:          timestamp,
+		SplitAddress:       splitAddress,
+		ExpectedController: expectedController,
+		Group:              group,
+		Monitor:            monitor,
+	}
+}
+
+func (v *Immutable) GetType() string {
+	return ImmutableType
+}
+
+func (v *Immutable) GetGroup() string {
+	return v.Group
+}
+
+func (v *Immutable) GetSeverity() event.Severity {
+	return ImmutableSeverity
+}
+
+func (v *Immutable) GetSubject() string {
+	return v.SplitAddress
+}
+
+func (v *Immutable) GetMonitor() string {
+	return v.Monitor
+}
+
+func (v *Immutable) GetPayload() *event.Payload {
+	return event.NewPayload(v, v.Timestamp, &ImmutableData{
+		SplitAddress:       v.SplitAddress,
+		ExpectedController: v.ExpectedController,
+		Message:            ImmutableMessage,
+	})
+}
+
+func (v *Immutable) GetTitle(includeMonitor, includeGroup bool) string {
+	var sb strings.Builder
+
+	if includeMonitor {
+		sb.WriteString("[")
+		sb.WriteString(v.Monitor)
+		sb.WriteString("] ")
+	}
+
+	sb.WriteString("Split is immutable")
+
+	return sb.String()
+}
+
+func (v *Immutable) GetDescriptionText(includeMonitor, includeGroup bool) string {
+	var sb strings.Builder
+
+	sb.WriteString("\nTimestamp: ")
+	sb.WriteString(v.Timestamp.UTC().Format("2006-01-02 15:04:05 UTC"))
+
+	if includeMonitor {
+		sb.WriteString("\nMonitor: ")
+		sb.WriteString(v.Monitor)
+	}
+
+	if includeGroup {
+		sb.WriteString("\nGroup: ")
+		sb.WriteString(v.Group)
+	}
+
+	sb.WriteString("\nSplit Address: ")
+	sb.WriteString(v.SplitAddress)
+	sb.WriteString("\nExpected Controller address: ")
+	sb.WriteString(v.ExpectedController)
+	sb.WriteString("\n")
+	sb.WriteString(ImmutableMessage)
+
+	return sb.String()
+}
+
+func (v *Immutable) GetDescriptionMarkdown(includeMonitor, includeGroup bool) string {
+	var sb strings.Builder
+
+	sb.WriteString("**Timestamp:** ")
+	sb.WriteString(v.Timestamp.UTC().Format("2006-01-02 15:04:05 UTC"))
+	sb.WriteString("\n")
+
+	if includeMonitor {
+		sb.WriteString("**Monitor:** ")
+		sb.WriteString(v.Monitor)
+		sb.WriteString("\n")
+	}
+
+	if includeGroup {
+		sb.WriteString("**Group:** ")
+		sb.WriteString(v.Group)
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("**Split Address:** `")
+	sb.WriteString(v.SplitAddress)
+	sb.WriteString("`\n")
+
+	sb.WriteString("**Expected Controller address:** `")
+	sb.WriteString(v.ExpectedController)
+	sb.WriteString("`\n")
+
+	sb.WriteString(ImmutableMessage)
+
+	return sb.String()
+}
+
+func (v *Immutable) GetDescriptionHTML(includeMonitor, includeGroup bool) string {
+	var sb strings.Builder
+
+	sb.WriteString("<p><strong>Timestamp:</strong> ")
+	sb.WriteString(v.Timestamp.UTC().Format("2006-01-02 15:04:05 UTC"))
+	sb.WriteString("</p>")
+
+	if includeMonitor {
+		sb.WriteString("<p><strong>Monitor:</strong> ")
+		sb.WriteString(v.Monitor)
+		sb.WriteString("</p>")
+	}
+
+	if includeGroup {
+		sb.WriteString("<p><strong>Group:</strong> ")
+		sb.WriteString(v.Group)
+		sb.WriteString("</p>")
+	}
+
+	sb.WriteString("<p><strong>Split Address:</strong> ")
+	sb.WriteString(v.SplitAddress)
+	sb.WriteString("</p>")
+
+	sb.WriteString("<p><strong>Expected Controller address:</strong> ")
+	sb.WriteString(v.ExpectedController)
+	sb.WriteString("</p>")
+
+	sb.WriteString("<p>")
+	sb.WriteString(ImmutableMessage)
+	sb.WriteString("</p>")
+
+	return sb.String()
+}
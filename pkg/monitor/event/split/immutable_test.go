@@ -0,0 +1,35 @@
+package split_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/split"
+)
+
+func TestImmutable(t *testing.T) {
+	timestamp := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	e := split.NewImmutable(timestamp, "test_monitor", "test_group", "0x123", "0x456")
+
+	assert.Equal(t, split.ImmutableType, e.GetType())
+	assert.Equal(t, event.SeverityCritical, e.GetSeverity())
+	assert.Equal(t, "0x123", event.GetSubject(e))
+	assert.Equal(t, "[test_monitor] Split is immutable", e.GetTitle(true, true))
+	assert.Equal(t, `
+Timestamp: 2024-01-01 12:00:00 UTC
+Monitor: test_monitor
+Group: test_group
+Split Address: 0x123
+Expected Controller address: 0x456
+`+split.ImmutableMessage, e.GetDescriptionText(true, true))
+	assert.Equal(t, "**Timestamp:** 2024-01-01 12:00:00 UTC\n**Split Address:** `0x123`\n**Expected Controller address:** `0x456`\n"+split.ImmutableMessage, e.GetDescriptionMarkdown(false, false))
+	assert.Contains(t, e.GetDescriptionHTML(false, false), "<p>"+split.ImmutableMessage+"</p>")
+	assert.Equal(t, &split.ImmutableData{
+		SplitAddress:       "0x123",
+		ExpectedController: "0x456",
+		Message:            split.ImmutableMessage,
+	}, e.GetPayload().Data)
+}
@@ -0,0 +1,178 @@
+package split
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+)
+
+// PendingControlTransfer is published when a control transfer of the split to an address
+// other than the configured controller has been initiated.
+type PendingControlTransfer struct {
+	Timestamp           time.Time
+	SplitAddress        string
+	ExpectedController  string
+	PotentialController string
+	Group               string
+	Monitor             string
+}
+
+// PendingControlTransferData is the payload data of a PendingControlTransfer event.
+type PendingControlTransferData struct {
+	SplitAddress        string `json:"splitAddress"`
+	ExpectedController  string `json:"expectedController"`
+	PotentialController string `json:"potentialController"`
+}
+
+const (
+	PendingControlTransferType     = "split_pending_control_transfer"
+	PendingControlTransferSeverity = event.SeverityCritical
+)
+
+func NewPendingControlTransfer(timestamp time.Time, monitor, group, splitAddress, expectedController, potentialController string) *PendingControlTransfer {
+	return &PendingControlTransfer{
+		Timestamp:           timestamp,
+		SplitAddress:        splitAddress,
+		ExpectedController:  expectedController,
+		PotentialController: potentialController,
+		Group:               group,
+		Monitor:             monitor,
+	}
+}
+
+func (v *PendingControlTransfer) GetType() string {
+	return PendingControlTransferType
+}
+
+func (v *PendingControlTransfer) GetGroup() string {
+	return v.Group
+}
+
+func (v *PendingControlTransfer) GetSeverity() event.Severity {
+	return PendingControlTransferSeverity
+}
+
+func (v *PendingControlTransfer) GetSubject() string {
+	return v.SplitAddress
+}
+
+func (v *PendingControlTransfer) GetMonitor() string {
+	return v.Monitor
+}
+
+func (v *PendingControlTransfer) GetPayload() *event.Payload {
+	return event.NewPayload(v, v.Timestamp, &PendingControlTransferData{
+		SplitAddress:        v.SplitAddress,
+		ExpectedController:  v.ExpectedController,
+		PotentialController: v.PotentialController,
+	})
+}
+
+func (v *PendingControlTransfer) GetTitle(includeMonitor, includeGroup bool) string {
+	var sb strings.Builder
+
+	if includeMonitor {
+		sb.WriteString("[")
+		sb.WriteString(v.Monitor)
+		sb.WriteString("] ")
+	}
+
+	sb.WriteString("Split control transfer pending")
+
+	return sb.String()
+}
+
+func (v *PendingControlTransfer) GetDescriptionText(includeMonitor, includeGroup bool) string {
+	var sb strings.Builder
+
+	sb.WriteString("\nTimestamp: ")
+	sb.WriteString(v.Timestamp.UTC().Format("2006-01-02 15:04:05 UTC"))
+
+	if includeMonitor {
+		sb.WriteString("\nMonitor: ")
+		sb.WriteString(v.Monitor)
+	}
+
+	if includeGroup {
+		sb.WriteString("\nGroup: ")
+		sb.WriteString(v.Group)
+	}
+
+	sb.WriteString("\nSplit Address: ")
+	sb.WriteString(v.SplitAddress)
+	sb.WriteString("\nExpected Controller address: ")
+	sb.WriteString(v.ExpectedController)
+	sb.WriteString("\nPotential Controller address: ")
+	sb.WriteString(v.PotentialController)
+
+	return sb.String()
+}
+
+func (v *PendingControlTransfer) GetDescriptionMarkdown(includeMonitor, includeGroup bool) string {
+	var sb strings.Builder
+
+	sb.WriteString("**Timestamp:** ")
+	sb.WriteString(v.Timestamp.UTC().Format("2006-01-02 15:04:05 UTC"))
+	sb.WriteString("\n")
+
+	if includeMonitor {
+		sb.WriteString("**Monitor:** ")
+		sb.WriteString(v.Monitor)
+		sb.WriteString("\n")
+	}
+
+	if includeGroup {
+		sb.WriteString("**Group:** ")
+		sb.WriteString(v.Group)
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("**Split Address:** `")
+	sb.WriteString(v.SplitAddress)
+	sb.WriteString("`\n")
+
+	sb.WriteString("**Expected Controller address:** `")
+	sb.WriteString(v.ExpectedController)
+	sb.WriteString("`\n")
+
+	sb.WriteString("**Potential Controller address:** `")
+	sb.WriteString(v.PotentialController)
+	sb.WriteString("`")
+
+	return sb.String()
+}
+
+func (v *PendingControlTransfer) GetDescriptionHTML(includeMonitor, includeGroup bool) string {
+	var sb strings.Builder
+
+	sb.WriteString("<p><strong>Timestamp:</strong> ")
+	sb.WriteString(v.Timestamp.UTC().Format("2006-01-02 15:04:05 UTC"))
+	sb.WriteString("</p>")
+
+	if includeMonitor {
+		sb.WriteString("<p><strong>Monitor:</strong> ")
+		sb.WriteString(v.Monitor)
+		sb.WriteString("</p>")
+	}
+
+	if includeGroup {
+		sb.WriteString("<p><strong>Group:</strong> ")
+		sb.WriteString(v.Group)
+		sb.WriteString("</p>")
+	}
+
+	sb.WriteString("<p><strong>Split Address:</strong> ")
+	sb.WriteString(v.SplitAddress)
+	sb.WriteString("</p>")
+
+	sb.WriteString("<p><strong>Expected Controller address:</strong> ")
+	sb.WriteString(v.ExpectedController)
+	sb.WriteString("</p>")
+
+	sb.WriteString("<p><strong>Potential Controller address:</strong> ")
+	sb.WriteString(v.PotentialController)
+	sb.WriteString("</p>")
+
+	return sb.String()
+}
@@ -0,0 +1,38 @@
+package split_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/split"
+)
+
+func TestPendingControlTransfer(t *testing.T) {
+	timestamp := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	e := split.NewPendingControlTransfer(timestamp, "test_monitor", "test_group", "0x123", "0x456", "0x789")
+
+	assert.Equal(t, split.PendingControlTransferType, e.GetType())
+	assert.Equal(t, event.SeverityCritical, e.GetSeverity())
+	assert.Equal(t, "test_group", e.GetGroup())
+	assert.Equal(t, "test_monitor", e.GetMonitor())
+	assert.Equal(t, "0x123", event.GetSubject(e))
+	assert.Equal(t, "[test_monitor] Split control transfer pending", e.GetTitle(true, true))
+	assert.Equal(t, "Split control transfer pending", e.GetTitle(false, false))
+	assert.Equal(t, `
+Timestamp: 2024-01-01 12:00:00 UTC
+Monitor: test_monitor
+Group: test_group
+Split Address: 0x123
+Expected Controller address: 0x456
+Potential Controller address: 0x789`, e.GetDescriptionText(true, true))
+	assert.Equal(t, "**Timestamp:** 2024-01-01 12:00:00 UTC\n**Split Address:** `0x123`\n**Expected Controller address:** `0x456`\n**Potential Controller address:** `0x789`", e.GetDescriptionMarkdown(false, false))
+	assert.Contains(t, e.GetDescriptionHTML(false, false), "<p><strong>Potential Controller address:</strong> 0x789</p>")
+	assert.Equal(t, &split.PendingControlTransferData{
+		SplitAddress:        "0x123",
+		ExpectedController:  "0x456",
+		PotentialController: "0x789",
+	}, e.GetPayload().Data)
+}
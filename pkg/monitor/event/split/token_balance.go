@@ -0,0 +1,216 @@
+package split
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+)
+
+// TokenBalance is published when the ERC-20 token balance of the split is below the
+// configured minimum or above the configured maximum.
+type TokenBalance struct {
+	Timestamp    time.Time
+	SplitAddress string
+	Token        string
+	Symbol       string
+	// Threshold is TokenBalanceMin or TokenBalanceMax.
+	Threshold string
+	// Balance and Limit are in whole tokens.
+	Balance string
+	Limit   string
+	Group   string
+	Monitor string
+}
+
+// TokenBalanceData is the payload data of a TokenBalance event.
+type TokenBalanceData struct {
+	SplitAddress string `json:"splitAddress"`
+	Token        string `json:"token"`
+	Symbol       string `json:"symbol"`
+	Threshold    string `json:"threshold"`
+	Balance      string `json:"balance"`
+	Limit        string `json:"limit"`
+}
+
+const (
+	TokenBalanceMin = "min"
+	TokenBalanceMax = "max"
+
+	TokenBalanceMinType  = "split_token_balance_min"
+	TokenBalanceMaxType  = "split_token_balance_max"
+	TokenBalanceSeverity = event.SeverityWarning
+	TokenBalanceMinTitle = "Split token balance below minimum"
+	TokenBalanceMaxTitle = "Split token balance above maximum"
+)
+
+func NewTokenBalance(timestamp time.Time, monitor, group, splitAddress, token, symbol, threshold, balance, limit string) *TokenBalance {
+	return &TokenBalance{
+		Timestamp:    timestamp,
+		SplitAddress: splitAddress,
+		Token:        token,
+		Symbol:       symbol,
+		Threshold:    threshold,
+		Balance:      balance,
+		Limit:        limit,
+		Group:        group,
+		Monitor:      monitor,
+	}
+}
+
+func (v *TokenBalance) GetType() string {
+	if v.Threshold == TokenBalanceMax {
+		return TokenBalanceMaxType
+	}
+
+	return TokenBalanceMinType
+}
+
+func (v *TokenBalance) GetGroup() string {
+	return v.Group
+}
+
+func (v *TokenBalance) GetSeverity() event.Severity {
+	return TokenBalanceSeverity
+}
+
+// GetSubject returns the token address, a split has one alert per token.
+func (v *TokenBalance) GetSubject() string {
+	return v.Token
+}
+
+func (v *TokenBalance) GetMonitor() string {
+	return v.Monitor
+}
+
+func (v *TokenBalance) GetPayload() *event.Payload {
+	return event.NewPayload(v, v.Timestamp, &TokenBalanceData{
+		SplitAddress: v.SplitAddress,
+		Token:        v.Token,
+		Symbol:       v.Symbol,
+		Threshold:    v.Threshold,
+		Balance:      v.Balance,
+		Limit:        v.Limit,
+	})
+}
+
+func (v *TokenBalance) GetTitle(includeMonitor, includeGroup bool) string {
+	var sb strings.Builder
+
+	if includeMonitor {
+		sb.WriteString("[")
+		sb.WriteString(v.Monitor)
+		sb.WriteString("] ")
+	}
+
+	if v.Threshold == TokenBalanceMax {
+		sb.WriteString(TokenBalanceMaxTitle)
+	} else {
+		sb.WriteString(TokenBalanceMinTitle)
+	}
+
+	return sb.String()
+}
+
+// fields returns the event specific fields in display order.
+func (v *TokenBalance) fields() [][2]string {
+	limit := "Minimum Balance"
+	if v.Threshold == TokenBalanceMax {
+		limit = "Maximum Balance"
+	}
+
+	token := v.Token
+	if v.Symbol != "" {
+		token = v.Symbol + " (" + v.Token + ")"
+	}
+
+	return [][2]string{
+		{"Split Address", v.SplitAddress},
+		{"Token", token},
+		{"Balance", v.Balance},
+		{limit, v.Limit},
+	}
+}
+
+func (v *TokenBalance) GetDescriptionText(includeMonitor, includeGroup bool) string {
+	var sb strings.Builder
+
+	sb.WriteString("\nTimestamp: ")
+	sb.WriteString(v.Timestamp.UTC().Format("2006-01-02 15:04:05 UTC"))
+
+	if includeMonitor {
+		sb.WriteString("\nMonitor: ")
+		sb.WriteString(v.Monitor)
+	}
+
+	if includeGroup {
+		sb.WriteString("\nGroup: ")
+		sb.WriteString(v.Group)
+	}
+
+	for _, f := range v.fields() {
+		sb.WriteString("\n")
+		sb.WriteString(f[0])
+		sb.WriteString(": ")
+		sb.WriteString(f[1])
+	}
+
+	return sb.String()
+}
+
+func (v *TokenBalance) GetDescriptionMarkdown(includeMonitor, includeGroup bool) string {
+	var sb strings.Builder
+
+	sb.WriteString("**Timestamp:** ")
+	sb.WriteString(v.Timestamp.UTC().Format("2006-01-02 15:04:05 UTC"))
+
+	if includeMonitor {
+		sb.WriteString("\n**Monitor:** ")
+		sb.WriteString(v.Monitor)
+	}
+
+	if includeGroup {
+		sb.WriteString("\n**Group:** ")
+		sb.WriteString(v.Group)
+	}
+
+	for _, f := range v.fields() {
+		sb.WriteString("\n**")
+		sb.WriteString(f[0])
+		sb.WriteString(":** `")
+		sb.WriteString(f[1])
+		sb.WriteString("`")
+	}
+
+	return sb.String()
+}
+
+func (v *TokenBalance) GetDescriptionHTML(includeMonitor, includeGroup bool) string {
+	var sb strings.Builder
+
+	sb.WriteString("<p><strong>Timestamp:</strong> ")
+	sb.WriteString(v.Timestamp.UTC().Format("2006-01-02 15:04:05 UTC"))
+	sb.WriteString("</p>")
+
+	if includeMonitor {
+		sb.WriteString("<p><strong>Monitor:</strong> ")
+		sb.WriteString(v.Monitor)
+		sb.WriteString("</p>")
+	}
+
+	if includeGroup {
+		sb.WriteString("<p><strong>Group:</strong> ")
+		sb.WriteString(v.Group)
+		sb.WriteString("</p>")
+	}
+
+	for _, f := range v.fields() {
+		sb.WriteString("<p><strong>")
+		sb.WriteString(f[0])
+		sb.WriteString(":</strong> ")
+		sb.WriteString(f[1])
+		sb.WriteString("</p>")
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,76 @@
+package split_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/split"
+)
+
+func TestTokenBalance(t *testing.T) {
+	timestamp := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		threshold string
+		symbol    string
+		wantType  string
+		wantTitle string
+		wantDesc  string
+	}{
+		{
+			name:      "below minimum",
+			threshold: split.TokenBalanceMin,
+			symbol:    "USDC",
+			wantType:  split.TokenBalanceMinType,
+			wantTitle: "[test_monitor] Split token balance below minimum",
+			wantDesc: `
+Timestamp: 2024-01-01 12:00:00 UTC
+Monitor: test_monitor
+Group: test_group
+Split Address: 0x123
+Token: USDC (0x456)
+Balance: 1.5
+Minimum Balance: 10`,
+		},
+		{
+			name:      "above maximum without symbol",
+			threshold: split.TokenBalanceMax,
+			wantType:  split.TokenBalanceMaxType,
+			wantTitle: "[test_monitor] Split token balance above maximum",
+			wantDesc: `
+Timestamp: 2024-01-01 12:00:00 UTC
+Monitor: test_monitor
+Group: test_group
+Split Address: 0x123
+Token: 0x456
+Balance: 1.5
+Maximum Balance: 10`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := split.NewTokenBalance(timestamp, "test_monitor", "test_group", "0x123", "0x456", tt.symbol, tt.threshold, "1.5", "10")
+
+			assert.Equal(t, tt.wantType, e.GetType())
+			assert.Equal(t, event.SeverityWarning, e.GetSeverity())
+			assert.Equal(t, "0x456", event.GetSubject(e))
+			assert.Equal(t, tt.wantTitle, e.GetTitle(true, true))
+			assert.Equal(t, tt.wantDesc, e.GetDescriptionText(true, true))
+			assert.Contains(t, e.GetDescriptionMarkdown(false, false), "**Balance:** `1.5`")
+			assert.Contains(t, e.GetDescriptionHTML(false, false), "<p><strong>Balance:</strong> 1.5</p>")
+			assert.Equal(t, &split.TokenBalanceData{
+				SplitAddress: "0x123",
+				Token:        "0x456",
+				Symbol:       tt.symbol,
+				Threshold:    tt.threshold,
+				Balance:      "1.5",
+				Limit:        "10",
+			}, e.GetPayload().Data)
+		})
+	}
+}
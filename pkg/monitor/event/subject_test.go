@@ -0,0 +1,62 @@
+package event_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/safe"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/split"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/validator"
+)
+
+func TestGetSubject(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		event         event.Event
+		wantSubject   string
+		wantAlertType string
+	}{
+		{
+			name:          "split event",
+			event:         split.NewHashUnknownState(now, "monitor", "group", "0xsplit", "expected", "actual"),
+			wantSubject:   "0xsplit",
+			wantAlertType: split.HashUnknownStateType,
+		},
+		{
+			name:          "safe event",
+			event:         safe.NewRecoveryTransactionMissing(now, "monitor", "group", "0xsafe"),
+			wantSubject:   "0xsafe",
+			wantAlertType: safe.RecoveryTransactionMissingType,
+		},
+		{
+			name:          "validator event",
+			event:         validator.NewStatus(now, "exited", "0xpubkey", "group", "monitor"),
+			wantSubject:   "0xpubkey",
+			wantAlertType: validator.StatusType,
+		},
+		{
+			name:          "resolved event",
+			event:         event.NewResolved(now, now, "monitor", "group", split.ControllerType, split.ControllerSeverity, "Split controller has changed", "0xsplit"),
+			wantSubject:   "0xsplit",
+			wantAlertType: split.ControllerType,
+		},
+		{
+			name:          "event without subject",
+			event:         NewMockEvent("monitor", "type", "title", "desc", "group"),
+			wantSubject:   "",
+			wantAlertType: "type",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantSubject, event.GetSubject(tt.event))
+			assert.Equal(t, tt.wantAlertType, event.GetAlertType(tt.event))
+		})
+	}
+}
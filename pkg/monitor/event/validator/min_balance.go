@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strings"
 	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
 )
 
 type MinBalance struct {
@@ -14,8 +16,16 @@ type MinBalance struct {
 	Monitor   string
 }
 
+// MinBalanceData is the payload data of a MinBalance event.
+type MinBalanceData struct {
+	Pubkey string `json:"pubkey"`
+	// Balance is the validator balance in gwei.
+	Balance uint64 `json:"balance"`
+}
+
 const (
-	MinBalanceType = "validator_min_balance"
+	MinBalanceType     = "validator_min_balance"
+	MinBalanceSeverity = event.SeverityWarning
 )
 
 func NewMinBalance(timestamp time.Time, balance uint64, pubkey, group, monitor string) *MinBalance {
@@ -36,10 +46,25 @@ func (v *MinBalance) GetGroup() string {
 	return v.Group
 }
 
+func (v *MinBalance) GetSeverity() event.Severity {
+	return MinBalanceSeverity
+}
+
+func (v *MinBalance) GetSubject() string {
+	return v.Pubkey
+}
+
 func (v *MinBalance) GetMonitor() string {
 	return v.Monitor
 }
 
+func (v *MinBalance) GetPayload() *event.Payload {
+	return event.NewPayload(v, v.Timestamp, &MinBalanceData{
+		Pubkey:  v.Pubkey,
+		Balance: v.Balance,
+	})
+}
+
 func (v *MinBalance) GetTitle(includeMonitor, includeGroup bool) string {
 	var sb strings.Builder
 
@@ -112,3 +112,17 @@ Balance: 16.0000 ETH`,
 		})
 	}
 }
+
+func TestMinBalancePayload(t *testing.T) {
+	timestamp := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	e := validator.NewMinBalance(timestamp, 31000000000, "0xabc", "test_group", "test_monitor")
+
+	payload := e.GetPayload()
+
+	assert.Equal(t, event.SchemaVersion, payload.SchemaVersion)
+	assert.Equal(t, validator.MinBalanceType, payload.Type)
+	assert.Equal(t, validator.MinBalanceSeverity, payload.Severity)
+	assert.Equal(t, "0xabc", payload.Subject)
+	assert.Equal(t, timestamp, payload.Timestamp)
+	assert.Equal(t, &validator.MinBalanceData{Pubkey: "0xabc", Balance: 31000000000}, payload.Data)
+}
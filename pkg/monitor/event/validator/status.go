@@ -3,6 +3,8 @@ package validator
 import (
 	"strings"
 	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
 )
 
 type Status struct {
@@ -13,10 +15,30 @@ type Status struct {
 	Monitor   string
 }
 
+// StatusData is the payload data of a Status event.
+type StatusData struct {
+	Pubkey string `json:"pubkey"`
+	Status string `json:"status"`
+}
+
 const (
-	StatusType = "validator_status"
+	StatusType     = "validator_status"
+	StatusSeverity = event.SeverityWarning
 )
 
+// slashedStatuses are escalated to critical.
+var slashedStatuses = map[string]bool{
+	"active_slashed":   true,
+	"exited_slashed":   true,
+	"slashing_online":  true,
+	"slashing_offline": true,
+}
+
+// IsSlashed returns true if the validator status is a slashed status.
+func IsSlashed(status string) bool {
+	return slashedStatuses[status]
+}
+
 func NewStatus(timestamp time.Time, status, pubkey, group, monitor string) *Status {
 	return &Status{
 		Timestamp: timestamp,
@@ -35,10 +57,29 @@ func (v *Status) GetGroup() string {
 	return v.Group
 }
 
+func (v *Status) GetSeverity() event.Severity {
+	if IsSlashed(v.Status) {
+		return event.SeverityCritical
+	}
+
+	return StatusSeverity
+}
+
+func (v *Status) GetSubject() string {
+	return v.Pubkey
+}
+
 func (v *Status) GetMonitor() string {
 	return v.Monitor
 }
 
+func (v *Status) GetPayload() *event.Payload {
+	return event.NewPayload(v, v.Timestamp, &StatusData{
+		Pubkey: v.Pubkey,
+		Status: v.Status,
+	})
+}
+
 func (v *Status) GetTitle(includeMonitor, includeGroup bool) string {
 	var sb strings.Builder
 
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strings"
 	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
 )
 
 type WithdrawalCredentials struct {
@@ -14,8 +16,15 @@ type WithdrawalCredentials struct {
 	Monitor   string
 }
 
+// WithdrawalCredentialsData is the payload data of a WithdrawalCredentials event.
+type WithdrawalCredentialsData struct {
+	Pubkey string `json:"pubkey"`
+	Code   int64  `json:"code"`
+}
+
 const (
-	WithdrawalCredentialsType = "validator_withdrawal_credentials"
+	WithdrawalCredentialsType     = "validator_withdrawal_credentials"
+	WithdrawalCredentialsSeverity = event.SeverityWarning
 )
 
 func NewWithdrawalCredentials(timestamp time.Time, code int64, pubkey, group, monitor string) *WithdrawalCredentials {
@@ -36,10 +45,25 @@ func (v *WithdrawalCredentials) GetGroup() string {
 	return v.Group
 }
 
+func (v *WithdrawalCredentials) GetSeverity() event.Severity {
+	return WithdrawalCredentialsSeverity
+}
+
+func (v *WithdrawalCredentials) GetSubject() string {
+	return v.Pubkey
+}
+
 func (v *WithdrawalCredentials) GetMonitor() string {
 	return v.Monitor
 }
 
+func (v *WithdrawalCredentials) GetPayload() *event.Payload {
+	return event.NewPayload(v, v.Timestamp, &WithdrawalCredentialsData{
+		Pubkey: v.Pubkey,
+		Code:   v.Code,
+	})
+}
+
 func (v *WithdrawalCredentials) GetTitle(includeMonitor, includeGroup bool) string {
 	var sb strings.Builder
 
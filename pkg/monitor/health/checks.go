@@ -0,0 +1,96 @@
+package health
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/status"
+)
+
+// BoolCheck reports a single component as healthy while fn returns true.
+func BoolCheck(name, unhealthyMessage string, fn func() bool) Check {
+	return func() []*Component {
+		component := &Component{
+			Name:    name,
+			Healthy: fn(),
+		}
+
+		if !component.Healthy {
+			component.Message = unhealthyMessage
+		}
+
+		return []*Component{component}
+	}
+}
+
+// ReachableCheck reports an external API as unhealthy if its last request failed.
+// An API that has not been requested yet is considered healthy.
+func ReachableCheck(name string, fn func() (reachable bool, checkedAt time.Time)) Check {
+	return func() []*Component {
+		reachable, checkedAt := fn()
+
+		component := &Component{
+			Name:    name,
+			Healthy: true,
+		}
+
+		switch {
+		case checkedAt.IsZero():
+			component.Message = "not requested yet"
+		case !reachable:
+			component.Healthy = false
+			component.Message = fmt.Sprintf("last request failed %s ago", time.Since(checkedAt).Round(time.Second))
+		default:
+			component.Message = fmt.Sprintf("last request succeeded %s ago", time.Since(checkedAt).Round(time.Second))
+		}
+
+		return []*Component{component}
+	}
+}
+
+// TickCheck reports every split and validator group as unhealthy if it has not had a
+// successful tick within maxAge. Groups that have never ticked are only unhealthy once
+// maxAge has passed since startedAt, unless strict is set.
+func TickCheck(splits []status.SplitProvider, validators []status.ValidatorProvider, maxAge time.Duration, startedAt time.Time, strict bool) Check {
+	return func() []*Component {
+		components := []*Component{}
+
+		for _, p := range splits {
+			for _, g := range p.SplitGroups() {
+				components = append(components, tickComponent("split/"+g.Name, g.LastTick, maxAge, startedAt, strict))
+			}
+		}
+
+		for _, p := range validators {
+			for _, g := range p.ValidatorGroups() {
+				components = append(components, tickComponent("validator/"+g.Name, g.LastTick, maxAge, startedAt, strict))
+			}
+		}
+
+		return components
+	}
+}
+
+func tickComponent(name string, lastTick *time.Time, maxAge time.Duration, startedAt time.Time, strict bool) *Component {
+	component := &Component{
+		Name:    name,
+		Healthy: true,
+	}
+
+	if lastTick == nil {
+		component.Message = "no successful tick yet"
+		component.Healthy = !strict && time.Since(startedAt) <= maxAge
+
+		return component
+	}
+
+	age := time.Since(*lastTick)
+
+	component.Message = fmt.Sprintf("last successful tick %s ago", age.Round(time.Second))
+
+	if age > maxAge {
+		component.Healthy = false
+	}
+
+	return component
+}
@@ -0,0 +1,19 @@
+package health
+
+import (
+	"fmt"
+	"time"
+)
+
+type Config struct {
+	// MaxTickAge is how long a split or validator group may go without a successful tick before it is unhealthy.
+	MaxTickAge time.Duration `yaml:"maxTickAge" default:"5m"`
+}
+
+func (c *Config) Validate() error {
+	if c.MaxTickAge < 0 {
+		return fmt.Errorf("max tick age must not be negative")
+	}
+
+	return nil
+}
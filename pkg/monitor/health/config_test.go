@@ -0,0 +1,39 @@
+package health_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/health"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *health.Config
+		wantErr bool
+	}{
+		{
+			name:    "valid config",
+			config:  &health.Config{MaxTickAge: 5 * time.Minute},
+			wantErr: false,
+		},
+		{
+			name:    "negative max tick age",
+			config:  &health.Config{MaxTickAge: -time.Minute},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Component is the health of a single part of the monitor.
+type Component struct {
+	Name    string `json:"name"`
+	Healthy bool   `json:"healthy"`
+	Message string `json:"message,omitempty"`
+}
+
+// Report is the combined health of every checked component.
+type Report struct {
+	Healthy    bool         `json:"healthy"`
+	Components []*Component `json:"components"`
+}
+
+// Check returns the health of one or more components.
+type Check func() []*Component
+
+// Checker serves liveness (/healthz) and readiness (/readyz) probes.
+type Checker struct {
+	log logrus.FieldLogger
+
+	liveness  []Check
+	readiness []Check
+
+	mu sync.Mutex
+}
+
+func NewChecker(log logrus.FieldLogger) *Checker {
+	return &Checker{
+		log: log.WithField("component", "health"),
+	}
+}
+
+// AddLivenessCheck registers a check that is part of the liveness probe.
+func (c *Checker) AddLivenessCheck(check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.liveness = append(c.liveness, check)
+}
+
+// AddReadinessCheck registers a check that is part of the readiness probe.
+func (c *Checker) AddReadinessCheck(check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.readiness = append(c.readiness, check)
+}
+
+func (c *Checker) Liveness() *Report {
+	c.mu.Lock()
+	checks := c.liveness
+	c.mu.Unlock()
+
+	return run(checks)
+}
+
+func (c *Checker) Readiness() *Report {
+	c.mu.Lock()
+	checks := c.readiness
+	c.mu.Unlock()
+
+	return run(checks)
+}
+
+func run(checks []Check) *Report {
+	report := &Report{
+		Healthy:    true,
+		Components: []*Component{},
+	}
+
+	for _, check := range checks {
+		for _, component := range check() {
+			if !component.Healthy {
+				report.Healthy = false
+			}
+
+			report.Components = append(report.Components, component)
+		}
+	}
+
+	return report
+}
+
+// Handler returns the probe endpoints, any other path is answered with the liveness report.
+func (c *Checker) Handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		c.writeReport(w, c.Liveness())
+	})
+
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		c.writeReport(w, c.Readiness())
+	})
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		c.writeReport(w, c.Liveness())
+	})
+
+	return mux
+}
+
+func (c *Checker) writeReport(w http.ResponseWriter, report *Report) {
+	code := http.StatusOK
+	if !report.Healthy {
+		code = http.StatusServiceUnavailable
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		c.log.WithError(err).Error("Error encoding health report")
+	}
+}
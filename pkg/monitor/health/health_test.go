@@ -0,0 +1,185 @@
+package health_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/health"
+	"github.com/ethpandaops/splitoor/pkg/monitor/status"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSplits struct {
+	groups []*status.SplitGroup
+}
+
+func (m *mockSplits) SplitGroups() []*status.SplitGroup {
+	return m.groups
+}
+
+type mockValidators struct {
+	groups []*status.ValidatorGroup
+}
+
+func (m *mockValidators) ValidatorGroups() []*status.ValidatorGroup {
+	return m.groups
+}
+
+func TestChecker_Handler(t *testing.T) {
+	tests := []struct {
+		name           string
+		path           string
+		executionReady bool
+		wantStatus     int
+		wantHealthy    bool
+	}{
+		{
+			name:           "healthz ignores readiness",
+			path:           "/healthz",
+			executionReady: false,
+			wantStatus:     http.StatusOK,
+			wantHealthy:    true,
+		},
+		{
+			name:           "readyz healthy",
+			path:           "/readyz",
+			executionReady: true,
+			wantStatus:     http.StatusOK,
+			wantHealthy:    true,
+		},
+		{
+			name:           "readyz unhealthy",
+			path:           "/readyz",
+			executionReady: false,
+			wantStatus:     http.StatusServiceUnavailable,
+			wantHealthy:    false,
+		},
+		{
+			name:           "root serves liveness",
+			path:           "/",
+			executionReady: false,
+			wantStatus:     http.StatusOK,
+			wantHealthy:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := health.NewChecker(logrus.New())
+			checker.AddLivenessCheck(health.BoolCheck("alive", "dead", func() bool { return true }))
+			checker.AddReadinessCheck(health.BoolCheck("execution", "no healthy execution nodes", func() bool { return tt.executionReady }))
+
+			rec := httptest.NewRecorder()
+			checker.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, http.NoBody))
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			var report health.Report
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+			assert.Equal(t, tt.wantHealthy, report.Healthy)
+			assert.NotEmpty(t, report.Components)
+		})
+	}
+}
+
+func TestReachableCheck(t *testing.T) {
+	tests := []struct {
+		name      string
+		reachable bool
+		checkedAt time.Time
+		want      bool
+	}{
+		{
+			name: "not requested yet",
+			want: true,
+		},
+		{
+			name:      "reachable",
+			reachable: true,
+			checkedAt: time.Now(),
+			want:      true,
+		},
+		{
+			name:      "unreachable",
+			reachable: false,
+			checkedAt: time.Now(),
+			want:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			components := health.ReachableCheck("safe", func() (bool, time.Time) {
+				return tt.reachable, tt.checkedAt
+			})()
+
+			require.Len(t, components, 1)
+			assert.Equal(t, "safe", components[0].Name)
+			assert.Equal(t, tt.want, components[0].Healthy)
+		})
+	}
+}
+
+func TestTickCheck(t *testing.T) {
+	recent := time.Now().Add(-time.Second)
+	stale := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name      string
+		lastTick  *time.Time
+		startedAt time.Time
+		strict    bool
+		want      bool
+	}{
+		{
+			name:      "recent tick",
+			lastTick:  &recent,
+			startedAt: stale,
+			want:      true,
+		},
+		{
+			name:      "stale tick",
+			lastTick:  &stale,
+			startedAt: stale,
+			want:      false,
+		},
+		{
+			name:      "no tick within grace period",
+			startedAt: time.Now(),
+			want:      true,
+		},
+		{
+			name:      "no tick after grace period",
+			startedAt: stale,
+			want:      false,
+		},
+		{
+			name:      "no tick strict",
+			startedAt: time.Now(),
+			strict:    true,
+			want:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			splits := &mockSplits{groups: []*status.SplitGroup{{Name: "split-1", LastTick: tt.lastTick}}}
+			validators := &mockValidators{groups: []*status.ValidatorGroup{{Name: "validators-1", LastTick: tt.lastTick}}}
+
+			components := health.TickCheck([]status.SplitProvider{splits}, []status.ValidatorProvider{validators}, time.Minute, tt.startedAt, tt.strict)()
+
+			require.Len(t, components, 2)
+			assert.Equal(t, "split/split-1", components[0].Name)
+			assert.Equal(t, "validator/validators-1", components[1].Name)
+
+			for _, c := range components {
+				assert.Equal(t, tt.want, c.Healthy)
+			}
+		})
+	}
+}
@@ -1,12 +1,123 @@
 package notifier
 
-import "github.com/ethpandaops/splitoor/pkg/monitor/notifier/source"
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/journal"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/silence"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source"
+)
 
 type Config struct {
 	Sources []source.Config `yaml:"sources"`
 	Docs    *string         `yaml:"docs"`
+	// Queue configures the per-source delivery queue.
+	Queue QueueConfig `yaml:"queue"`
+	// Reminders configures how often still firing alerts are re-notified.
+	Reminders ReminderConfig `yaml:"reminders"`
+	// Silences suppress notifications for matching events, eg. during maintenance windows.
+	Silences []silence.Silence `yaml:"silences"`
+	// Journal records every published event so the event history can be queried.
+	Journal journal.Config `yaml:"journal"`
 }
 
 func (c *Config) Validate() error {
 	return nil
 }
+
+type QueueConfig struct {
+	// Size is the maximum number of pending events per source, events are dead-lettered when the queue is full.
+	Size int `yaml:"size" default:"100"`
+	// MaxAttempts is the number of times delivery to a source is attempted before the event is dead-lettered.
+	MaxAttempts int `yaml:"maxAttempts" default:"5"`
+	// Timeout is the timeout of a single delivery attempt.
+	Timeout time.Duration `yaml:"timeout" default:"15s"`
+	// InitialBackoff is the wait before the first retry, it doubles on every retry.
+	InitialBackoff time.Duration `yaml:"initialBackoff" default:"5s"`
+	// MaxBackoff caps the wait between retries.
+	MaxBackoff time.Duration `yaml:"maxBackoff" default:"5m"`
+	// DrainTimeout bounds the last delivery attempt of the pending events on shutdown, events
+	// that aren't delivered in time are dead-lettered. 0 dead-letters them without an attempt.
+	DrainTimeout time.Duration `yaml:"drainTimeout" default:"10s"`
+	// DeadLetterPath is an optional file that dead-lettered events are appended to as JSON lines.
+	DeadLetterPath *string `yaml:"deadLetterPath"`
+}
+
+func (c *QueueConfig) Validate() error {
+	if c.Size <= 0 {
+		return errors.New("queue size must be greater than 0")
+	}
+
+	if c.MaxAttempts <= 0 {
+		return errors.New("queue max attempts must be greater than 0")
+	}
+
+	if c.Timeout <= 0 {
+		return errors.New("queue timeout must be greater than 0")
+	}
+
+	if c.InitialBackoff <= 0 {
+		return errors.New("queue initial backoff must be greater than 0")
+	}
+
+	if c.MaxBackoff < c.InitialBackoff {
+		return errors.New("queue max backoff must not be less than initial backoff")
+	}
+
+	if c.DrainTimeout < 0 {
+		return errors.New("queue drain timeout must not be negative")
+	}
+
+	if c.DeadLetterPath != nil && *c.DeadLetterPath == "" {
+		return errors.New("queue dead letter path must not be empty")
+	}
+
+	return nil
+}
+
+// Backoff returns the wait before the given retry, starting at 1.
+func (c *QueueConfig) Backoff(retry int) time.Duration {
+	backoff := c.InitialBackoff
+
+	for i := 1; i < retry; i++ {
+		backoff *= 2
+
+		if backoff >= c.MaxBackoff {
+			return c.MaxBackoff
+		}
+	}
+
+	return backoff
+}
+
+type ReminderConfig struct {
+	// Interval is how often a still firing alert is re-notified, 0 disables reminders.
+	Interval time.Duration `yaml:"interval"`
+	// Events overrides the interval per event type, eg. split_controller: 1h. 0 disables reminders for the event type.
+	Events map[string]time.Duration `yaml:"events"`
+}
+
+func (c *ReminderConfig) Validate() error {
+	if c.Interval < 0 {
+		return errors.New("reminder interval must not be negative")
+	}
+
+	for eventType, interval := range c.Events {
+		if interval < 0 {
+			return fmt.Errorf("reminder interval for event type %s must not be negative", eventType)
+		}
+	}
+
+	return nil
+}
+
+// GetInterval returns the reminder interval for the event type, 0 if reminders are disabled.
+func (c *ReminderConfig) GetInterval(eventType string) time.Duration {
+	if interval, ok := c.Events[eventType]; ok {
+		return interval
+	}
+
+	return c.Interval
+}
@@ -2,6 +2,7 @@ package notifier
 
 import (
 	"testing"
+	"time"
 
 	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source"
 	"github.com/stretchr/testify/assert"
@@ -47,3 +48,108 @@ func TestConfigValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestQueueConfigValidate(t *testing.T) {
+	valid := func() *QueueConfig {
+		return &QueueConfig{
+			Size:           100,
+			MaxAttempts:    5,
+			Timeout:        15 * time.Second,
+			InitialBackoff: 5 * time.Second,
+			MaxBackoff:     5 * time.Minute,
+		}
+	}
+
+	empty := ""
+
+	tests := []struct {
+		name        string
+		modify      func(c *QueueConfig)
+		expectError bool
+	}{
+		{
+			name:   "valid config",
+			modify: func(c *QueueConfig) {},
+		},
+		{
+			name:        "zero size",
+			modify:      func(c *QueueConfig) { c.Size = 0 },
+			expectError: true,
+		},
+		{
+			name:        "zero max attempts",
+			modify:      func(c *QueueConfig) { c.MaxAttempts = 0 },
+			expectError: true,
+		},
+		{
+			name:        "zero timeout",
+			modify:      func(c *QueueConfig) { c.Timeout = 0 },
+			expectError: true,
+		},
+		{
+			name:        "max backoff less than initial backoff",
+			modify:      func(c *QueueConfig) { c.MaxBackoff = time.Second },
+			expectError: true,
+		},
+		{
+			name:        "negative drain timeout",
+			modify:      func(c *QueueConfig) { c.DrainTimeout = -time.Second },
+			expectError: true,
+		},
+		{
+			name:        "empty dead letter path",
+			modify:      func(c *QueueConfig) { c.DeadLetterPath = &empty },
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := valid()
+			tt.modify(config)
+
+			err := config.Validate()
+			if tt.expectError {
+				assert.Error(t, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestQueueConfigBackoff(t *testing.T) {
+	config := &QueueConfig{
+		InitialBackoff: 5 * time.Second,
+		MaxBackoff:     time.Minute,
+	}
+
+	assert.Equal(t, 5*time.Second, config.Backoff(1))
+	assert.Equal(t, 10*time.Second, config.Backoff(2))
+	assert.Equal(t, 20*time.Second, config.Backoff(3))
+	assert.Equal(t, 40*time.Second, config.Backoff(4))
+	assert.Equal(t, time.Minute, config.Backoff(5))
+	assert.Equal(t, time.Minute, config.Backoff(10))
+}
+
+func TestReminderConfig(t *testing.T) {
+	config := &ReminderConfig{
+		Interval: time.Hour,
+		Events: map[string]time.Duration{
+			"split_controller": 10 * time.Minute,
+			"validator_status": 0,
+		},
+	}
+
+	assert.NoError(t, config.Validate())
+	assert.Equal(t, time.Hour, config.GetInterval("split_hash_unknown_state"))
+	assert.Equal(t, 10*time.Minute, config.GetInterval("split_controller"))
+	assert.Equal(t, time.Duration(0), config.GetInterval("validator_status"))
+
+	assert.Equal(t, time.Duration(0), (&ReminderConfig{}).GetInterval("split_controller"))
+
+	assert.Error(t, (&ReminderConfig{Interval: -time.Minute}).Validate())
+	assert.Error(t, (&ReminderConfig{Events: map[string]time.Duration{"split_controller": -time.Minute}}).Validate())
+}
@@ -0,0 +1,24 @@
+package journal
+
+import "errors"
+
+type Config struct {
+	// Path is an optional file the journal is appended to as JSON lines, the journal is
+	// only kept in memory if it is not set.
+	Path *string `yaml:"path"`
+	// MaxEntries is the maximum number of entries kept in memory for queries. The file is
+	// compacted to the latest MaxEntries entries once it holds twice as many.
+	MaxEntries int `yaml:"maxEntries" default:"10000"`
+}
+
+func (c *Config) Validate() error {
+	if c.Path != nil && *c.Path == "" {
+		return errors.New("journal path must not be empty")
+	}
+
+	if c.MaxEntries <= 0 {
+		return errors.New("journal max entries must be greater than 0")
+	}
+
+	return nil
+}
@@ -0,0 +1,377 @@
+package journal
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/sirupsen/logrus"
+)
+
+// Entry is a single event recorded in the journal.
+type Entry struct {
+	ID         uint64    `json:"id"`
+	RecordedAt time.Time `json:"recordedAt"`
+	Title      string    `json:"title"`
+	// Silenced is true if the event matched a silence and was not sent to any source.
+	Silenced bool `json:"silenced"`
+	// ResolvedAt is when the alert of the event was resolved, nil if it is still firing or
+	// the event is not an alert.
+	ResolvedAt *time.Time     `json:"resolvedAt,omitempty"`
+	Event      *event.Payload `json:"event"`
+}
+
+// Query filters the entries returned by List, every field is optional.
+type Query struct {
+	Group string
+	// Type matches the event type or the type of the alert the event belongs to, so
+	// alerts are returned along with their reminders and resolved events.
+	Type  string
+	Since time.Time
+	// Limit is the maximum number of entries returned, 0 returns every entry.
+	Limit int
+}
+
+// Journal is an append-only log of every event published through the notifier. Resolution
+// times are not written back to the log, they are derived from the resolved events when
+// the log is loaded. The log file is compacted to the entries kept in memory once it holds
+// twice as many, so it never grows beyond 2 * MaxEntries lines.
+type Journal struct {
+	log    logrus.FieldLogger
+	config *Config
+
+	mu      sync.RWMutex
+	file    *os.File
+	entries []*Entry
+	nextID  uint64
+	// lines is the number of entries in the log file
+	lines int
+}
+
+func New(log logrus.FieldLogger, config *Config) *Journal {
+	return &Journal{
+		log:    log.WithField("component", "journal"),
+		config: config,
+		nextID: 1,
+	}
+}
+
+// Start loads the existing journal file and opens it for appending.
+func (j *Journal) Start(ctx context.Context) error {
+	if j.config.Path == nil {
+		return nil
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if err := j.load(*j.config.Path); err != nil {
+		return fmt.Errorf("failed to load journal: %w", err)
+	}
+
+	if j.lines > len(j.entries) {
+		if err := j.compact(); err != nil {
+			return fmt.Errorf("failed to compact journal: %w", err)
+		}
+	} else {
+		f, err := os.OpenFile(*j.config.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+		if err != nil {
+			return err
+		}
+
+		j.file = f
+	}
+
+	j.log.WithFields(logrus.Fields{
+		"path":    *j.config.Path,
+		"entries": len(j.entries),
+	}).Info("Loaded event journal")
+
+	return nil
+}
+
+func (j *Journal) Stop(ctx context.Context) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.file == nil {
+		return nil
+	}
+
+	err := j.file.Close()
+	j.file = nil
+
+	return err
+}
+
+// Record appends the event to the journal, resolved events set the resolution time of
+// the alert entries they resolve.
+func (j *Journal) Record(e event.Event, silenced bool, now time.Time) (*Entry, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	entry := &Entry{
+		ID:         j.nextID,
+		RecordedAt: now.UTC(),
+		Title:      e.GetTitle(false, false),
+		Silenced:   silenced,
+		Event:      e.GetPayload(),
+	}
+
+	j.add(entry)
+
+	if j.file == nil {
+		return entry, nil
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return entry, err
+	}
+
+	if _, err := j.file.Write(append(data, '\n')); err != nil {
+		return entry, err
+	}
+
+	j.lines++
+
+	if j.lines >= 2*j.config.MaxEntries {
+		if err := j.compact(); err != nil {
+			return entry, fmt.Errorf("failed to compact journal: %w", err)
+		}
+	}
+
+	return entry, nil
+}
+
+// compact rewrites the log file with the entries kept in memory and reopens it for
+// appending, must be called with j.mu held.
+func (j *Journal) compact() error {
+	path := *j.config.Path
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(tmp.Name())
+
+	w := bufio.NewWriter(tmp)
+
+	for _, entry := range j.entries {
+		data, err := json.Marshal(entry)
+		if err != nil {
+			tmp.Close()
+
+			return err
+		}
+
+		if _, err := w.Write(append(data, '\n')); err != nil {
+			tmp.Close()
+
+			return err
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		tmp.Close()
+
+		return err
+	}
+
+	if err := tmp.Chmod(0o600); err != nil {
+		tmp.Close()
+
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if j.file != nil {
+		if err := j.file.Close(); err != nil {
+			return err
+		}
+
+		j.file = nil
+	}
+
+	// the previous file is appended to again if it can't be replaced
+	renameErr := os.Rename(tmp.Name(), path)
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	j.file = f
+
+	if renameErr != nil {
+		return renameErr
+	}
+
+	j.lines = len(j.entries)
+
+	j.log.WithField("entries", j.lines).Debug("Compacted event journal")
+
+	return nil
+}
+
+// List returns the entries matching the query, newest first.
+func (j *Journal) List(q Query) []*Entry {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+
+	entries := []*Entry{}
+
+	for i := len(j.entries) - 1; i >= 0; i-- {
+		entry := j.entries[i]
+
+		if !q.Matches(entry) {
+			continue
+		}
+
+		c := *entry
+		entries = append(entries, &c)
+
+		if q.Limit > 0 && len(entries) >= q.Limit {
+			break
+		}
+	}
+
+	return entries
+}
+
+// Matches returns true if the entry matches every set field of the query.
+func (q *Query) Matches(entry *Entry) bool {
+	if q.Group != "" && entry.Event.Group != q.Group {
+		return false
+	}
+
+	if q.Type != "" && entry.Event.Type != q.Type && entry.Event.AlertType != q.Type {
+		return false
+	}
+
+	if !q.Since.IsZero() && entry.RecordedAt.Before(q.Since) {
+		return false
+	}
+
+	return true
+}
+
+// ParseSince parses an RFC3339 timestamp or a duration relative to now, eg. 24h.
+func ParseSince(value string, now time.Time) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid since %q, must be an RFC3339 timestamp or a duration", value)
+	}
+
+	if d < 0 {
+		return time.Time{}, errors.New("since duration must not be negative")
+	}
+
+	return now.Add(-d), nil
+}
+
+// load replays the journal file, must be called with j.mu held.
+func (j *Journal) load(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	line := 0
+
+	for scanner.Scan() {
+		line++
+
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		// invalid lines are counted too so they are removed by the next compaction
+		j.lines++
+
+		var entry Entry
+		if err := json.Unmarshal([]byte(text), &entry); err != nil || entry.Event == nil {
+			// a partially written last line shouldn't prevent the monitor from starting
+			j.log.WithField("line", line).Warn("Skipping invalid journal entry")
+
+			continue
+		}
+
+		entry.ResolvedAt = nil
+
+		j.add(&entry)
+	}
+
+	return scanner.Err()
+}
+
+// add appends the entry to the in-memory journal, must be called with j.mu held.
+func (j *Journal) add(entry *Entry) {
+	if entry.ID >= j.nextID {
+		j.nextID = entry.ID + 1
+	}
+
+	if entry.Event.Resolved {
+		j.resolve(entry)
+	}
+
+	j.entries = append(j.entries, entry)
+
+	if len(j.entries) > j.config.MaxEntries {
+		j.entries = append([]*Entry{}, j.entries[len(j.entries)-j.config.MaxEntries:]...)
+	}
+}
+
+// resolve sets the resolution time of the unresolved entries of the alert, must be called
+// with j.mu held.
+func (j *Journal) resolve(resolved *Entry) {
+	resolvedAt := resolved.Event.Timestamp
+
+	for i := len(j.entries) - 1; i >= 0; i-- {
+		entry := j.entries[i]
+
+		if entry.Event.AlertType != resolved.Event.AlertType ||
+			entry.Event.Group != resolved.Event.Group ||
+			entry.Event.Subject != resolved.Event.Subject {
+			continue
+		}
+
+		// earlier entries were resolved by the previous resolved event of the alert
+		if entry.Event.Resolved {
+			return
+		}
+
+		if entry.ResolvedAt == nil {
+			entry.ResolvedAt = &resolvedAt
+		}
+	}
+}
@@ -0,0 +1,214 @@
+package journal_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/split"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/journal"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigValidate(t *testing.T) {
+	empty := ""
+	path := "events.jsonl"
+
+	tests := []struct {
+		name    string
+		config  journal.Config
+		wantErr bool
+	}{
+		{name: "memory", config: journal.Config{MaxEntries: 10}},
+		{name: "file", config: journal.Config{Path: &path, MaxEntries: 10}},
+		{name: "empty path", config: journal.Config{Path: &empty, MaxEntries: 10}, wantErr: true},
+		{name: "no max entries", config: journal.Config{}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestJournal(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	config := &journal.Config{Path: &path, MaxEntries: 100}
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	j := journal.New(logrus.New(), config)
+	require.NoError(t, j.Start(ctx))
+
+	recovery := split.NewHashRecoveryState(start, "monitor", "group-1", "0x123", "0xabc")
+	resolved := event.NewResolved(start.Add(time.Hour), start, "monitor", "group-1", split.HashRecoveryStateType, split.HashRecoveryStateSeverity, recovery.GetTitle(false, false), "0x123")
+	other := split.NewController(start, "monitor", "group-2", "0x456", "0x1", "0x2")
+
+	_, err := j.Record(recovery, false, start)
+	require.NoError(t, err)
+	_, err = j.Record(other, true, start.Add(time.Minute))
+	require.NoError(t, err)
+	_, err = j.Record(resolved, false, start.Add(time.Hour))
+	require.NoError(t, err)
+
+	assertEntries := func(j *journal.Journal) {
+		entries := j.List(journal.Query{})
+		require.Len(t, entries, 3)
+		assert.Equal(t, uint64(3), entries[0].ID)
+		assert.Equal(t, uint64(1), entries[2].ID)
+
+		entries = j.List(journal.Query{Group: "group-1", Type: split.HashRecoveryStateType})
+		require.Len(t, entries, 2)
+		assert.True(t, entries[0].Event.Resolved)
+		require.NotNil(t, entries[1].ResolvedAt)
+		assert.True(t, start.Add(time.Hour).Equal(*entries[1].ResolvedAt))
+		assert.Equal(t, "0x123", entries[1].Event.Subject)
+
+		entries = j.List(journal.Query{Type: split.ControllerType})
+		require.Len(t, entries, 1)
+		assert.True(t, entries[0].Silenced)
+		assert.Nil(t, entries[0].ResolvedAt)
+
+		assert.Len(t, j.List(journal.Query{Since: start.Add(30 * time.Minute)}), 1)
+		assert.Len(t, j.List(journal.Query{Limit: 2}), 2)
+	}
+
+	assertEntries(j)
+
+	require.NoError(t, j.Stop(ctx))
+
+	// the journal is replayed from the file, including the resolution times
+	restored := journal.New(logrus.New(), config)
+	require.NoError(t, restored.Start(ctx))
+
+	defer restored.Stop(ctx)
+
+	assertEntries(restored)
+
+	data, ok := restored.List(journal.Query{Limit: 1, Type: split.HashRecoveryStateType + event.ResolvedTypeSuffix})[0].Event.Data.(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, recovery.GetTitle(false, false), data["alertTitle"])
+
+	_, err = restored.Record(other, false, start.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), restored.List(journal.Query{Limit: 1})[0].ID)
+}
+
+func TestJournalMaxEntries(t *testing.T) {
+	j := journal.New(logrus.New(), &journal.Config{MaxEntries: 2})
+	require.NoError(t, j.Start(context.Background()))
+
+	for i := 0; i < 3; i++ {
+		_, err := j.Record(split.NewHashInitialState(time.Now(), "monitor", "group-1", "0x123", "0xabc"), false, time.Now())
+		require.NoError(t, err)
+	}
+
+	entries := j.List(journal.Query{})
+	require.Len(t, entries, 2)
+	assert.Equal(t, uint64(3), entries[0].ID)
+	assert.Equal(t, uint64(2), entries[1].ID)
+}
+
+func TestJournalCompaction(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	config := &journal.Config{Path: &path, MaxEntries: 2}
+
+	j := journal.New(logrus.New(), config)
+	require.NoError(t, j.Start(ctx))
+
+	for i := 0; i < 3; i++ {
+		_, err := j.Record(split.NewHashInitialState(time.Now(), "monitor", "group-1", "0x123", "0xabc"), false, time.Now())
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 3, countLines(t, path))
+
+	// the file is compacted to the entries kept in memory once it holds twice as many
+	_, err := j.Record(split.NewHashInitialState(time.Now(), "monitor", "group-1", "0x123", "0xabc"), false, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 2, countLines(t, path))
+
+	_, err = j.Record(split.NewHashInitialState(time.Now(), "monitor", "group-1", "0x123", "0xabc"), false, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 3, countLines(t, path))
+
+	require.NoError(t, j.Stop(ctx))
+
+	// a file with more entries than kept in memory is compacted on start
+	restored := journal.New(logrus.New(), config)
+	require.NoError(t, restored.Start(ctx))
+
+	defer restored.Stop(ctx)
+
+	assert.Equal(t, 2, countLines(t, path))
+
+	entries := restored.List(journal.Query{})
+	require.Len(t, entries, 2)
+	assert.Equal(t, uint64(5), entries[0].ID)
+	assert.Equal(t, uint64(4), entries[1].ID)
+}
+
+func countLines(t *testing.T, path string) int {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	return strings.Count(string(data), "\n")
+}
+
+func TestJournalSkipsInvalidLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	require.NoError(t, os.WriteFile(path, []byte("{\"id\":1,\"event\":{\"type\":\"split_controller\"}}\n{\"id\":2,\"ev"), 0o600))
+
+	j := journal.New(logrus.New(), &journal.Config{Path: &path, MaxEntries: 10})
+	require.NoError(t, j.Start(context.Background()))
+
+	defer j.Stop(context.Background())
+
+	assert.Len(t, j.List(journal.Query{}), 1)
+}
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "empty", value: ""},
+		{name: "timestamp", value: "2024-01-01T00:00:00Z", want: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "duration", value: "24h", want: now.Add(-24 * time.Hour)},
+		{name: "negative duration", value: "-1h", wantErr: true},
+		{name: "invalid", value: "yesterday", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := journal.ParseSince(tt.value, now)
+			if tt.wantErr {
+				assert.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equal(got))
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package notifier
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type Metrics struct {
+	queueLength *prometheus.GaugeVec
+	delivered   *prometheus.CounterVec
+	retries     *prometheus.CounterVec
+	deadLetters *prometheus.CounterVec
+}
+
+var (
+	metricsInstance *Metrics
+	once            sync.Once
+)
+
+func GetMetricsInstance(namespace, monitor string) *Metrics {
+	once.Do(func() {
+		constLabels := prometheus.Labels{"monitor": monitor}
+		labels := []string{"source", "source_type"}
+
+		metricsInstance = &Metrics{
+			queueLength: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace:   namespace,
+					Name:        "queue_length",
+					Help:        "Number of events waiting to be delivered to a source",
+					ConstLabels: constLabels,
+				},
+				labels,
+			),
+			delivered: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace:   namespace,
+					Name:        "delivered_total",
+					Help:        "Total number of events delivered to a source",
+					ConstLabels: constLabels,
+				},
+				labels,
+			),
+			retries: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace:   namespace,
+					Name:        "retries_total",
+					Help:        "Total number of delivery retries to a source",
+					ConstLabels: constLabels,
+				},
+				labels,
+			),
+			deadLetters: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace:   namespace,
+					Name:        "dead_letters_total",
+					Help:        "Total number of events that could not be delivered to a source by reason",
+					ConstLabels: constLabels,
+				},
+				append(labels, "reason"),
+			),
+		}
+
+		prometheus.MustRegister(metricsInstance.queueLength)
+		prometheus.MustRegister(metricsInstance.delivered)
+		prometheus.MustRegister(metricsInstance.retries)
+		prometheus.MustRegister(metricsInstance.deadLetters)
+	})
+
+	return metricsInstance
+}
+
+func (m Metrics) SetQueueLength(source, sourceType string, length int) {
+	m.queueLength.WithLabelValues(source, sourceType).Set(float64(length))
+}
+
+func (m Metrics) IncDelivered(source, sourceType string) {
+	m.delivered.WithLabelValues(source, sourceType).Inc()
+}
+
+func (m Metrics) IncRetries(source, sourceType string) {
+	m.retries.WithLabelValues(source, sourceType).Inc()
+}
+
+func (m Metrics) IncDeadLetters(source, sourceType, reason string) {
+	m.deadLetters.WithLabelValues(source, sourceType, reason).Inc()
+}
@@ -2,86 +2,377 @@ package notifier
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/journal"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/silence"
 	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/templates"
+	"github.com/ethpandaops/splitoor/pkg/monitor/store"
 	"github.com/sirupsen/logrus"
 )
 
+// silenceCheckInterval is how often silenced alerts are checked for the end of their silence.
+const silenceCheckInterval = 15 * time.Second
+
 type Publisher struct {
-	log     logrus.FieldLogger
-	sources []SourceWithConfig
+	log       logrus.FieldLogger
+	sources   []SourceWithConfig
+	reminders ReminderConfig
+	silences  *silence.Manager
+	journal   *journal.Journal
+
+	// silenced are the silenced alerts by alert key, they are published when their silence
+	// ends unless they are resolved first. They are only kept in memory so alerts silenced
+	// before a restart rely on reminders.
+	silenced   map[string]event.Event
+	silencedMu sync.Mutex
+
+	started bool
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	mu      sync.Mutex
 }
 
 type SourceWithConfig struct {
-	source source.Source
-	group  *string
+	source    source.Source
+	config    source.Config
+	templates *templates.Templates
+	queue     *queue
 }
 
-func NewPublisher(ctx context.Context, log logrus.FieldLogger, monitor string, conf Config) (*Publisher, error) {
+func NewPublisher(ctx context.Context, log logrus.FieldLogger, monitor string, conf Config, st store.Store) (*Publisher, error) {
+	if err := conf.Queue.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := conf.Reminders.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := conf.Journal.Validate(); err != nil {
+		return nil, err
+	}
+
+	silences, err := silence.NewManager(log, monitor, conf.Silences, st)
+	if err != nil {
+		return nil, err
+	}
+
 	sources, err := createSources(ctx, log, monitor, conf.Docs, conf.Sources)
 	if err != nil {
 		return nil, err
 	}
 
+	p := newPublisher(log, monitor, &conf.Queue, sources)
+	p.reminders = conf.Reminders
+	p.silences = silences
+	p.journal = journal.New(log, &conf.Journal)
+
+	return p, nil
+}
+
+func newPublisher(log logrus.FieldLogger, monitor string, conf *QueueConfig, sources []SourceWithConfig) *Publisher {
+	metrics := GetMetricsInstance("splitoor_notifier", monitor)
+
+	var deadLetter *deadLetterLog
+	if conf.DeadLetterPath != nil {
+		deadLetter = &deadLetterLog{path: *conf.DeadLetterPath}
+	}
+
+	for i := range sources {
+		sources[i].queue = newQueue(log, sources[i].source, conf, metrics, deadLetter)
+	}
+
 	return &Publisher{
-		log:     log,
-		sources: sources,
-	}, nil
+		log:      log,
+		sources:  sources,
+		silenced: make(map[string]event.Event),
+	}
 }
 
 func createSources(ctx context.Context, log logrus.FieldLogger, monitor string, docs *string, conf []source.Config) ([]SourceWithConfig, error) {
 	sources := make([]SourceWithConfig, len(conf))
 
 	for i, src := range conf {
+		if err := src.Validate(); err != nil {
+			return nil, err
+		}
+
+		tmpl, err := templates.New(log.WithField("source", src.Name), src.Templates)
+		if err != nil {
+			return nil, fmt.Errorf("notifier source %s: %w", src.Name, err)
+		}
+
 		s, err := source.NewSource(ctx, log, monitor, src.Name, docs, src.SourceType, src.IncludeMonitorName, src.Group == nil, src.Config)
 		if err != nil {
 			return nil, err
 		}
 
 		sources[i] = SourceWithConfig{
-			source: s,
-			group:  src.Group,
+			source:    s,
+			config:    src,
+			templates: tmpl,
 		}
 	}
 
 	return sources, nil
 }
 
+// Publish queues the event for every matching source, delivery happens asynchronously.
+// An error is only returned if the event could not be queued for one or more sources.
+// Events matching an active silence are dropped, every event is recorded in the journal.
 func (p *Publisher) Publish(e event.Event) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
-	defer cancel()
+	if p.silences != nil {
+		s := p.silences.Silenced(e, time.Now())
+
+		p.trackSilenced(e, s)
+
+		if s != nil {
+			p.log.WithFields(logrus.Fields{
+				"silence": s.ID,
+				"group":   e.GetGroup(),
+				"type":    e.GetType(),
+			}).Debug("Event silenced")
+
+			p.record(e, true)
+
+			return nil
+		}
+	}
+
+	p.record(e, false)
+
+	return p.enqueue(e)
+}
+
+func (p *Publisher) enqueue(e event.Event) error {
+	var errs []error
 
 	for _, src := range p.sources {
-		if src.group != nil && e.GetGroup() != *src.group {
+		if !src.config.Matches(e) {
 			continue
 		}
 
-		if err := src.source.Publish(ctx, e); err != nil {
-			return err
+		if err := src.queue.enqueue(src.templates.Apply(e)); err != nil {
+			errs = append(errs, err)
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
+}
+
+// trackSilenced holds a silenced alert until its silence ends, s is the silence matching
+// the event, nil if it isn't silenced. A held alert is forgotten once it is resolved, or
+// notified by an unsilenced event, silenced reminders keep it held. Notices are not held,
+// they aren't firing anymore once the silence ends.
+func (p *Publisher) trackSilenced(e event.Event, s *silence.Silence) {
+	if event.IsDigest(e) || event.IsNotice(e) {
+		return
+	}
+
+	key := strings.Join([]string{e.GetMonitor(), e.GetGroup(), event.GetAlertType(e), event.GetSubject(e)}, "/")
+
+	p.silencedMu.Lock()
+	defer p.silencedMu.Unlock()
+
+	switch {
+	case s == nil || event.IsResolved(e):
+		delete(p.silenced, key)
+	case !event.IsReminder(e):
+		p.silenced[key] = e
+	}
+}
+
+// releaseSilenced publishes the silenced alerts whose silence has ended, the alerts are
+// still firing as they weren't resolved in the meantime.
+func (p *Publisher) releaseSilenced(now time.Time) {
+	released := []event.Event{}
+
+	p.silencedMu.Lock()
+
+	for key, e := range p.silenced {
+		if p.silences.Matches(e, now) != nil {
+			continue
+		}
+
+		delete(p.silenced, key)
+
+		released = append(released, e)
+	}
+
+	p.silencedMu.Unlock()
+
+	for _, e := range released {
+		p.log.WithFields(logrus.Fields{
+			"group": e.GetGroup(),
+			"type":  e.GetType(),
+		}).Info("Publishing alert silenced until now")
+
+		p.record(e, false)
+
+		if err := p.enqueue(e); err != nil {
+			p.log.WithError(err).WithField("type", e.GetType()).Error("Failed to publish silenced alert")
+		}
+	}
+}
+
+func (p *Publisher) runSilences(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(silenceCheckInterval):
+			p.releaseSilenced(time.Now())
+		}
+	}
+}
+
+// PublishTo queues the event for the named sources only, bypassing the routing and
+// silences of the sources. It is used for scheduled reports such as digests.
+func (p *Publisher) PublishTo(e event.Event, names []string) error {
+	p.record(e, false)
+
+	var errs []error
+
+	for _, src := range p.sources {
+		if !slices.Contains(names, src.source.GetName()) {
+			continue
+		}
+
+		if err := src.queue.enqueue(src.templates.Apply(e)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// record adds the event to the journal, failures are logged as the event should still
+// be delivered.
+func (p *Publisher) record(e event.Event, silenced bool) {
+	if p.journal == nil {
+		return
+	}
+
+	if _, err := p.journal.Record(e, silenced, time.Now()); err != nil {
+		p.log.WithError(err).WithField("type", e.GetType()).Error("Failed to record event in journal")
+	}
+}
+
+// HasSource returns true if a source with the given name is configured.
+func (p *Publisher) HasSource(name string) bool {
+	for _, src := range p.sources {
+		if src.source.GetName() == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ReminderInterval returns how often a still firing alert of the event type should be
+// re-notified, 0 if reminders are disabled.
+func (p *Publisher) ReminderInterval(eventType string) time.Duration {
+	return p.reminders.GetInterval(eventType)
+}
+
+// Silences returns the silence manager, nil if the publisher was created without one.
+func (p *Publisher) Silences() *silence.Manager {
+	return p.silences
+}
+
+// Journal returns the event journal, nil if the publisher was created without one.
+func (p *Publisher) Journal() *journal.Journal {
+	return p.journal
 }
 
 func (p *Publisher) Start(ctx context.Context) error {
+	if p.silences != nil {
+		if err := p.silences.Start(ctx); err != nil {
+			return err
+		}
+	}
+
+	if p.journal != nil {
+		if err := p.journal.Start(ctx); err != nil {
+			return err
+		}
+	}
+
 	for _, src := range p.sources {
 		if err := src.source.Start(ctx); err != nil {
 			return err
 		}
 	}
 
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	queueCtx, cancel := context.WithCancel(ctx)
+	p.cancel = cancel
+
+	if p.silences != nil {
+		p.wg.Add(1)
+
+		go func() {
+			defer p.wg.Done()
+
+			p.runSilences(queueCtx)
+		}()
+	}
+
+	for _, src := range p.sources {
+		p.wg.Add(1)
+
+		go func(q *queue) {
+			defer p.wg.Done()
+
+			q.run(queueCtx)
+		}(src.queue)
+	}
+
+	p.started = true
+
 	return nil
 }
 
+// Started returns true once every source has been started.
+func (p *Publisher) Started() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.started
+}
+
 func (p *Publisher) Stop(ctx context.Context) error {
+	p.mu.Lock()
+	p.started = false
+
+	if p.cancel != nil {
+		p.cancel()
+		p.cancel = nil
+	}
+	p.mu.Unlock()
+
+	// wait for in-flight deliveries to be cancelled and a last attempt to deliver the pending events
+	p.wg.Wait()
+
 	for _, src := range p.sources {
 		if err := src.source.Stop(ctx); err != nil {
 			return err
 		}
 	}
 
+	if p.journal != nil {
+		return p.journal.Stop(ctx)
+	}
+
 	return nil
 }
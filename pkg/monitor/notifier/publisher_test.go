@@ -0,0 +1,402 @@
+package notifier
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/split"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/journal"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/silence"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSource struct {
+	name string
+
+	mu        sync.Mutex
+	failures  int
+	block     chan struct{}
+	attempts  int
+	published []event.Event
+}
+
+func (s *fakeSource) Start(ctx context.Context) error { return nil }
+func (s *fakeSource) Stop(ctx context.Context) error  { return nil }
+func (s *fakeSource) GetType() string                 { return "fake" }
+func (s *fakeSource) GetName() string                 { return s.name }
+
+func (s *fakeSource) Publish(ctx context.Context, e event.Event) error {
+	if s.block != nil {
+		select {
+		case <-s.block:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.attempts++
+
+	if s.failures < 0 || s.attempts <= s.failures {
+		return errors.New("unavailable")
+	}
+
+	s.published = append(s.published, e)
+
+	return nil
+}
+
+func (s *fakeSource) Published() []event.Event {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]event.Event{}, s.published...)
+}
+
+func (s *fakeSource) Attempts() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.attempts
+}
+
+func testQueueConfig() *QueueConfig {
+	return &QueueConfig{
+		Size:           10,
+		MaxAttempts:    3,
+		Timeout:        time.Second,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     5 * time.Millisecond,
+		DrainTimeout:   50 * time.Millisecond,
+	}
+}
+
+func testEvent(group string) event.Event {
+	return split.NewHashInitialState(time.Now(), "monitor", group, "0x123", "hash")
+}
+
+func TestPublisherRetries(t *testing.T) {
+	src := &fakeSource{name: "flaky", failures: 2}
+
+	p := newPublisher(logrus.New(), "monitor", testQueueConfig(), []SourceWithConfig{{source: src}})
+	require.NoError(t, p.Start(context.Background()))
+
+	defer p.Stop(context.Background())
+
+	require.NoError(t, p.Publish(testEvent("group")))
+
+	assert.Eventually(t, func() bool { return len(src.Published()) == 1 }, time.Second, time.Millisecond)
+	assert.Equal(t, 3, src.Attempts())
+}
+
+func TestPublisherFailingSourceDoesNotBlockOthers(t *testing.T) {
+	failing := &fakeSource{name: "failing", block: make(chan struct{})}
+	healthy := &fakeSource{name: "healthy"}
+
+	p := newPublisher(logrus.New(), "monitor", testQueueConfig(), []SourceWithConfig{{source: failing}, {source: healthy}})
+	require.NoError(t, p.Start(context.Background()))
+
+	defer p.Stop(context.Background())
+
+	require.NoError(t, p.Publish(testEvent("group")))
+	require.NoError(t, p.Publish(testEvent("group")))
+
+	assert.Eventually(t, func() bool { return len(healthy.Published()) == 2 }, time.Second, time.Millisecond)
+	assert.Empty(t, failing.Published())
+
+	close(failing.block)
+
+	assert.Eventually(t, func() bool { return len(failing.Published()) == 2 }, time.Second, time.Millisecond)
+}
+
+func TestPublisherGroupFilter(t *testing.T) {
+	group := "group-1"
+	filtered := &fakeSource{name: "filtered"}
+	all := &fakeSource{name: "all"}
+
+	p := newPublisher(logrus.New(), "monitor", testQueueConfig(), []SourceWithConfig{{source: filtered, config: source.Config{Group: &group}}, {source: all}})
+	require.NoError(t, p.Start(context.Background()))
+
+	defer p.Stop(context.Background())
+
+	require.NoError(t, p.Publish(testEvent("group-1")))
+	require.NoError(t, p.Publish(testEvent("group-2")))
+
+	assert.Eventually(t, func() bool { return len(all.Published()) == 2 }, time.Second, time.Millisecond)
+	require.Len(t, filtered.Published(), 1)
+	assert.Equal(t, "group-1", filtered.Published()[0].GetGroup())
+}
+
+func TestPublisherSilences(t *testing.T) {
+	src := &fakeSource{name: "all"}
+
+	silences, err := silence.NewManager(logrus.New(), "monitor", []silence.Silence{
+		{Group: "group-1", End: time.Now().Add(time.Hour)},
+	}, nil)
+	require.NoError(t, err)
+
+	p := newPublisher(logrus.New(), "monitor", testQueueConfig(), []SourceWithConfig{{source: src}})
+	p.silences = silences
+
+	require.NoError(t, p.Start(context.Background()))
+
+	defer p.Stop(context.Background())
+
+	require.NoError(t, p.Publish(testEvent("group-1")))
+	require.NoError(t, p.Publish(testEvent("group-2")))
+
+	assert.Eventually(t, func() bool { return len(src.Published()) == 1 }, time.Second, time.Millisecond)
+	assert.Equal(t, "group-2", src.Published()[0].GetGroup())
+}
+
+func TestPublisherSilenceEnds(t *testing.T) {
+	src := &fakeSource{name: "all"}
+
+	silences, err := silence.NewManager(logrus.New(), "monitor", nil, nil)
+	require.NoError(t, err)
+
+	p := newPublisher(logrus.New(), "monitor", testQueueConfig(), []SourceWithConfig{{source: src}})
+	p.silences = silences
+
+	require.NoError(t, p.Start(context.Background()))
+
+	defer p.Stop(context.Background())
+
+	ctx := context.Background()
+
+	s1, err := silences.Add(ctx, silence.Silence{Group: "group-1", End: time.Now().Add(time.Hour)})
+	require.NoError(t, err)
+
+	s2, err := silences.Add(ctx, silence.Silence{Group: "group-2", End: time.Now().Add(time.Hour)})
+	require.NoError(t, err)
+
+	require.NoError(t, p.Publish(testEvent("group-1")))
+	require.NoError(t, p.Publish(testEvent("group-2")))
+
+	// the alert of group-1 is reminded while silenced
+	require.NoError(t, p.Publish(event.NewReminder(time.Now(), time.Now(), testEvent("group-1"))))
+
+	// the alert of group-2 is resolved while silenced
+	require.NoError(t, p.Publish(event.NewResolved(time.Now(), time.Now(), "monitor", "group-2", split.HashInitialStateType, split.HashInitialStateSeverity, "title", "0x123")))
+
+	p.releaseSilenced(time.Now())
+	assert.Empty(t, src.Published())
+
+	require.NoError(t, silences.Delete(ctx, s1.ID))
+	require.NoError(t, silences.Delete(ctx, s2.ID))
+
+	// only the alert that is still firing is published once the silence ends
+	p.releaseSilenced(time.Now())
+
+	assert.Eventually(t, func() bool { return len(src.Published()) == 1 }, time.Second, time.Millisecond)
+	assert.Equal(t, "group-1", src.Published()[0].GetGroup())
+
+	p.releaseSilenced(time.Now())
+	assert.Never(t, func() bool { return len(src.Published()) > 1 }, 50*time.Millisecond, time.Millisecond)
+}
+
+func TestPublisherJournal(t *testing.T) {
+	src := &fakeSource{name: "all"}
+
+	silences, err := silence.NewManager(logrus.New(), "monitor", []silence.Silence{
+		{Group: "group-1", End: time.Now().Add(time.Hour)},
+	}, nil)
+	require.NoError(t, err)
+
+	p := newPublisher(logrus.New(), "monitor", testQueueConfig(), []SourceWithConfig{{source: src}})
+	p.silences = silences
+	p.journal = journal.New(logrus.New(), &journal.Config{MaxEntries: 10})
+
+	require.NoError(t, p.Start(context.Background()))
+
+	defer p.Stop(context.Background())
+
+	require.NoError(t, p.Publish(testEvent("group-1")))
+	require.NoError(t, p.Publish(testEvent("group-2")))
+	require.NoError(t, p.PublishTo(testEvent("group-3"), []string{"all"}))
+
+	// silenced events are recorded too
+	entries := p.Journal().List(journal.Query{})
+	require.Len(t, entries, 3)
+	assert.Equal(t, "group-3", entries[0].Event.Group)
+	assert.False(t, entries[1].Silenced)
+	assert.True(t, entries[2].Silenced)
+}
+
+func TestPublisherPublishTo(t *testing.T) {
+	group := "group-1"
+	filtered := &fakeSource{name: "filtered"}
+	other := &fakeSource{name: "other"}
+
+	p := newPublisher(logrus.New(), "monitor", testQueueConfig(), []SourceWithConfig{{source: filtered, config: source.Config{Group: &group}}, {source: other}})
+	require.NoError(t, p.Start(context.Background()))
+
+	defer p.Stop(context.Background())
+
+	assert.True(t, p.HasSource("filtered"))
+	assert.False(t, p.HasSource("unknown"))
+
+	// the named sources receive the event regardless of their routing
+	require.NoError(t, p.PublishTo(testEvent("group-2"), []string{"filtered"}))
+
+	assert.Eventually(t, func() bool { return len(filtered.Published()) == 1 }, time.Second, time.Millisecond)
+	assert.Empty(t, other.Published())
+}
+
+func TestPublisherDeadLetter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dead-letters.jsonl")
+
+	config := testQueueConfig()
+	config.DeadLetterPath = &path
+
+	src := &fakeSource{name: "down", failures: -1}
+
+	p := newPublisher(logrus.New(), "monitor", config, []SourceWithConfig{{source: src}})
+	require.NoError(t, p.Start(context.Background()))
+
+	defer p.Stop(context.Background())
+
+	require.NoError(t, p.Publish(testEvent("group")))
+
+	assert.Eventually(t, func() bool {
+		_, err := os.Stat(path)
+
+		return err == nil
+	}, time.Second, time.Millisecond)
+
+	assert.Equal(t, config.MaxAttempts, src.Attempts())
+
+	letters := readDeadLetters(t, path)
+	require.Len(t, letters, 1)
+	assert.Equal(t, DeadLetterReasonMaxAttempts, letters[0]["reason"])
+	assert.Equal(t, "down", letters[0]["source"])
+	assert.Equal(t, split.HashInitialStateType, letters[0]["type"])
+	assert.Equal(t, "unavailable", letters[0]["error"])
+
+	payload, ok := letters[0]["event"].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, split.HashInitialStateType, payload["type"])
+	assert.Equal(t, "group", payload["group"])
+	assert.NotNil(t, payload["data"])
+}
+
+func TestPublisherQueueFull(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dead-letters.jsonl")
+
+	config := testQueueConfig()
+	config.Size = 1
+	config.DeadLetterPath = &path
+
+	src := &fakeSource{name: "slow"}
+
+	// not started, so nothing is consumed from the queue
+	p := newPublisher(logrus.New(), "monitor", config, []SourceWithConfig{{source: src}})
+
+	require.NoError(t, p.Publish(testEvent("group")))
+
+	err := p.Publish(testEvent("group"))
+	assert.ErrorIs(t, err, ErrQueueFull)
+
+	letters := readDeadLetters(t, path)
+	require.Len(t, letters, 1)
+	assert.Equal(t, DeadLetterReasonQueueFull, letters[0]["reason"])
+}
+
+func TestPublisherStopDeadLettersPending(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dead-letters.jsonl")
+
+	config := testQueueConfig()
+	config.DeadLetterPath = &path
+
+	src := &fakeSource{name: "stuck", block: make(chan struct{})}
+
+	p := newPublisher(logrus.New(), "monitor", config, []SourceWithConfig{{source: src}})
+	require.NoError(t, p.Start(context.Background()))
+
+	require.NoError(t, p.Publish(testEvent("group")))
+	require.NoError(t, p.Publish(testEvent("group")))
+
+	require.NoError(t, p.Stop(context.Background()))
+	assert.False(t, p.Started())
+
+	letters := readDeadLetters(t, path)
+	require.Len(t, letters, 2)
+
+	for _, letter := range letters {
+		assert.Equal(t, DeadLetterReasonStopped, letter["reason"])
+	}
+}
+
+func TestPublisherStopDeliversPending(t *testing.T) {
+	src := &fakeSource{name: "source"}
+
+	p := newPublisher(logrus.New(), "monitor", testQueueConfig(), []SourceWithConfig{{source: src}})
+
+	require.NoError(t, p.Publish(testEvent("group")))
+	require.NoError(t, p.Publish(testEvent("group")))
+
+	// the queues stop straight away, the pending events are delivered while draining
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	require.NoError(t, p.Start(ctx))
+	require.NoError(t, p.Stop(context.Background()))
+
+	assert.Len(t, src.Published(), 2)
+}
+
+func TestPublisherStopRetriesInFlight(t *testing.T) {
+	config := testQueueConfig()
+	config.InitialBackoff = time.Hour
+	config.MaxBackoff = time.Hour
+
+	src := &fakeSource{name: "flaky", failures: 1}
+
+	p := newPublisher(logrus.New(), "monitor", config, []SourceWithConfig{{source: src}})
+	require.NoError(t, p.Start(context.Background()))
+
+	require.NoError(t, p.Publish(testEvent("group")))
+
+	assert.Eventually(t, func() bool { return src.Attempts() == 1 }, time.Second, time.Millisecond)
+
+	// the event waiting for its retry gets a final attempt while draining
+	require.NoError(t, p.Stop(context.Background()))
+
+	assert.Len(t, src.Published(), 1)
+}
+
+func readDeadLetters(t *testing.T, path string) []map[string]interface{} {
+	t.Helper()
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+
+	defer f.Close()
+
+	letters := []map[string]interface{}{}
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var letter map[string]interface{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &letter))
+
+		letters = append(letters, letter)
+	}
+
+	require.NoError(t, scanner.Err())
+
+	return letters
+}
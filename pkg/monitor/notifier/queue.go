@@ -0,0 +1,254 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	DeadLetterReasonQueueFull   = "queue_full"
+	DeadLetterReasonMaxAttempts = "max_attempts"
+	DeadLetterReasonStopped     = "stopped"
+)
+
+var ErrQueueFull = errors.New("notifier queue is full")
+
+type delivery struct {
+	event    event.Event
+	attempts int
+	queuedAt time.Time
+}
+
+// queue delivers events to a single source in order, retrying failed deliveries with
+// exponential backoff so that a failing source never blocks the others.
+type queue struct {
+	log        logrus.FieldLogger
+	source     source.Source
+	config     *QueueConfig
+	metrics    *Metrics
+	deadLetter *deadLetterLog
+
+	deliveries chan *delivery
+}
+
+func newQueue(log logrus.FieldLogger, src source.Source, config *QueueConfig, metrics *Metrics, deadLetter *deadLetterLog) *queue {
+	return &queue{
+		log:        log.WithFields(logrus.Fields{"source": src.GetName(), "source_type": src.GetType()}),
+		source:     src,
+		config:     config,
+		metrics:    metrics,
+		deadLetter: deadLetter,
+		deliveries: make(chan *delivery, config.Size),
+	}
+}
+
+// enqueue adds an event to the queue without blocking.
+func (q *queue) enqueue(e event.Event) error {
+	d := &delivery{
+		event:    e,
+		queuedAt: time.Now(),
+	}
+
+	select {
+	case q.deliveries <- d:
+		q.metrics.SetQueueLength(q.source.GetName(), q.source.GetType(), len(q.deliveries))
+
+		return nil
+	default:
+		q.dead(d, DeadLetterReasonQueueFull, ErrQueueFull)
+
+		return fmt.Errorf("%w: %s", ErrQueueFull, q.source.GetName())
+	}
+}
+
+func (q *queue) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			q.drain(nil)
+
+			return
+		case d := <-q.deliveries:
+			q.metrics.SetQueueLength(q.source.GetName(), q.source.GetType(), len(q.deliveries))
+
+			if !q.deliver(ctx, d) {
+				q.drain(d)
+
+				return
+			}
+		}
+	}
+}
+
+// deliver retries the delivery until it succeeds or runs out of attempts, it returns false
+// if the queue is stopped while the event is still waiting for a retry.
+func (q *queue) deliver(ctx context.Context, d *delivery) bool {
+	for {
+		d.attempts++
+
+		err := q.publish(ctx, d.event)
+		if err == nil {
+			q.metrics.IncDelivered(q.source.GetName(), q.source.GetType())
+
+			return true
+		}
+
+		if d.attempts >= q.config.MaxAttempts {
+			q.dead(d, DeadLetterReasonMaxAttempts, err)
+
+			return true
+		}
+
+		backoff := q.config.Backoff(d.attempts)
+
+		q.log.WithError(err).WithFields(logrus.Fields{
+			"type":     d.event.GetType(),
+			"group":    d.event.GetGroup(),
+			"attempts": d.attempts,
+			"backoff":  backoff,
+		}).Warn("Failed to publish event, retrying")
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(backoff):
+			q.metrics.IncRetries(q.source.GetName(), q.source.GetType())
+		}
+	}
+}
+
+func (q *queue) publish(ctx context.Context, e event.Event) error {
+	ctx, cancel := context.WithTimeout(ctx, q.config.Timeout)
+	defer cancel()
+
+	return q.source.Publish(ctx, e)
+}
+
+// drain makes a single delivery attempt for the in-flight event, if any, and every event
+// still waiting in the queue, events that can't be delivered within the drain timeout are
+// dead-lettered.
+func (q *queue) drain(inFlight *delivery) {
+	ctx, cancel := context.WithTimeout(context.Background(), q.config.DrainTimeout)
+	defer cancel()
+
+	if inFlight != nil {
+		q.deliverOnce(ctx, inFlight)
+	}
+
+	for {
+		select {
+		case d := <-q.deliveries:
+			q.deliverOnce(ctx, d)
+		default:
+			q.metrics.SetQueueLength(q.source.GetName(), q.source.GetType(), 0)
+
+			return
+		}
+	}
+}
+
+func (q *queue) deliverOnce(ctx context.Context, d *delivery) {
+	if ctx.Err() != nil {
+		q.dead(d, DeadLetterReasonStopped, errors.New("notifier stopped"))
+
+		return
+	}
+
+	d.attempts++
+
+	if err := q.publish(ctx, d.event); err != nil {
+		q.dead(d, DeadLetterReasonStopped, err)
+
+		return
+	}
+
+	q.metrics.IncDelivered(q.source.GetName(), q.source.GetType())
+}
+
+func (q *queue) dead(d *delivery, reason string, err error) {
+	q.metrics.IncDeadLetters(q.source.GetName(), q.source.GetType(), reason)
+
+	q.log.WithError(err).WithFields(logrus.Fields{
+		"type":      d.event.GetType(),
+		"group":     d.event.GetGroup(),
+		"title":     d.event.GetTitle(true, true),
+		"reason":    reason,
+		"attempts":  d.attempts,
+		"queued_at": d.queuedAt,
+	}).Error("Dead-lettered event")
+
+	if q.deadLetter == nil {
+		return
+	}
+
+	if wErr := q.deadLetter.Write(&DeadLetter{
+		Timestamp:  time.Now(),
+		Source:     q.source.GetName(),
+		SourceType: q.source.GetType(),
+		Reason:     reason,
+		Error:      err.Error(),
+		Attempts:   d.attempts,
+		QueuedAt:   d.queuedAt,
+		Type:       d.event.GetType(),
+		Monitor:    d.event.GetMonitor(),
+		Group:      d.event.GetGroup(),
+		Title:      d.event.GetTitle(true, true),
+		Event:      d.event.GetPayload(),
+	}); wErr != nil {
+		q.log.WithError(wErr).Error("Failed to write dead letter")
+	}
+}
+
+// DeadLetter is an event that could not be delivered to a source.
+type DeadLetter struct {
+	Timestamp  time.Time      `json:"timestamp"`
+	Source     string         `json:"source"`
+	SourceType string         `json:"sourceType"`
+	Reason     string         `json:"reason"`
+	Error      string         `json:"error"`
+	Attempts   int            `json:"attempts"`
+	QueuedAt   time.Time      `json:"queuedAt"`
+	Type       string         `json:"type"`
+	Monitor    string         `json:"monitor"`
+	Group      string         `json:"group"`
+	Title      string         `json:"title"`
+	Event      *event.Payload `json:"event"`
+}
+
+// deadLetterLog appends dead letters to a file as JSON lines.
+type deadLetterLog struct {
+	path string
+	mu   sync.Mutex
+}
+
+func (l *deadLetterLog) Write(letter *DeadLetter) error {
+	data, err := json.Marshal(letter)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	f, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		f.Close()
+
+		return err
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,294 @@
+package silence
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/ethpandaops/splitoor/pkg/monitor/store"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	ErrNotFound = errors.New("silence not found")
+	ErrStatic   = errors.New("silence is configured statically and can't be deleted")
+)
+
+// Manager holds the static silences from the config file and the silences created at
+// runtime, runtime silences are persisted in the store so they survive restarts.
+type Manager struct {
+	log     logrus.FieldLogger
+	monitor string
+	store   store.Store
+	metrics *Metrics
+
+	mu       sync.RWMutex
+	silences []*Silence
+}
+
+func NewManager(log logrus.FieldLogger, monitor string, static []Silence, st store.Store) (*Manager, error) {
+	m := &Manager{
+		log:      log.WithField("component", "silence"),
+		monitor:  monitor,
+		store:    st,
+		metrics:  GetMetricsInstance("splitoor_notifier", monitor),
+		silences: make([]*Silence, 0, len(static)),
+	}
+
+	for i := range static {
+		s := static[i]
+
+		if s.ID == "" {
+			s.ID = fmt.Sprintf("config-%d", i)
+		}
+
+		if err := s.Validate(); err != nil {
+			return nil, fmt.Errorf("silence %s: %w", s.ID, err)
+		}
+
+		s.Static = true
+
+		m.silences = append(m.silences, &s)
+	}
+
+	return m, nil
+}
+
+// Start restores the runtime silences from the store, expired silences are dropped.
+func (m *Manager) Start(ctx context.Context) error {
+	if m.store == nil {
+		return nil
+	}
+
+	data, err := m.store.Get(ctx, m.storeKey())
+	if err != nil {
+		return err
+	}
+
+	if data == nil {
+		return nil
+	}
+
+	var restored []*Silence
+	if err := json.Unmarshal(data, &restored); err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, s := range restored {
+		if s.GetState(now) == StateExpired {
+			continue
+		}
+
+		s.Static = false
+
+		m.silences = append(m.silences, s)
+	}
+
+	m.updateMetrics(now)
+
+	return nil
+}
+
+// Add creates a runtime silence, the start defaults to now.
+func (m *Manager) Add(ctx context.Context, s Silence) (*Silence, error) {
+	now := time.Now()
+
+	if s.Start.IsZero() {
+		s.Start = now
+	}
+
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+
+	s.ID = id
+	s.Static = false
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.silences = append(m.silences, &s)
+
+	if err := m.save(ctx, now); err != nil {
+		m.silences = m.silences[:len(m.silences)-1]
+
+		return nil, err
+	}
+
+	m.updateMetrics(now)
+
+	m.log.WithFields(logrus.Fields{
+		"id":         s.ID,
+		"group":      s.Group,
+		"event_type": s.EventType,
+		"subject":    s.Subject,
+		"end":        s.End,
+	}).Info("Added silence")
+
+	return &s, nil
+}
+
+// Delete removes a runtime silence.
+func (m *Manager) Delete(ctx context.Context, id string) error {
+	now := time.Now()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i, s := range m.silences {
+		if s.ID != id {
+			continue
+		}
+
+		if s.Static {
+			return ErrStatic
+		}
+
+		silences := m.silences
+
+		m.silences = append(append([]*Silence{}, silences[:i]...), silences[i+1:]...)
+
+		if err := m.save(ctx, now); err != nil {
+			m.silences = silences
+
+			return err
+		}
+
+		m.updateMetrics(now)
+
+		m.log.WithField("id", id).Info("Deleted silence")
+
+		return nil
+	}
+
+	return ErrNotFound
+}
+
+// List returns the pending and active silences ordered by start, or every silence if
+// all is true.
+func (m *Manager) List(now time.Time, all bool) []*Silence {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	silences := []*Silence{}
+
+	for _, s := range m.silences {
+		if all || s.GetState(now) != StateExpired {
+			c := *s
+			silences = append(silences, &c)
+		}
+	}
+
+	sort.SliceStable(silences, func(i, j int) bool {
+		return silences[i].Start.Before(silences[j].Start)
+	})
+
+	return silences
+}
+
+// Silenced returns the first active silence matching the event, or nil if the event
+// should be published.
+func (m *Manager) Silenced(e event.Event, now time.Time) *Silence {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	m.updateMetrics(now)
+
+	s := m.match(e, now)
+	if s != nil {
+		m.metrics.IncSilenced(e.GetGroup(), event.GetAlertType(e))
+	}
+
+	return s
+}
+
+// Matches returns the first active silence matching the event like Silenced, without
+// counting the event as silenced.
+func (m *Manager) Matches(e event.Event, now time.Time) *Silence {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.match(e, now)
+}
+
+// match must be called with m.mu held.
+func (m *Manager) match(e event.Event, now time.Time) *Silence {
+	for _, s := range m.silences {
+		if s.Matches(e, now) {
+			return s
+		}
+	}
+
+	return nil
+}
+
+// save persists the runtime silences, must be called with m.mu held.
+func (m *Manager) save(ctx context.Context, now time.Time) error {
+	if m.store == nil {
+		return nil
+	}
+
+	silences := []*Silence{}
+
+	for _, s := range m.silences {
+		if !s.Static && s.GetState(now) != StateExpired {
+			silences = append(silences, s)
+		}
+	}
+
+	data, err := json.Marshal(silences)
+	if err != nil {
+		return err
+	}
+
+	return m.store.Set(ctx, m.storeKey(), data)
+}
+
+// updateMetrics must be called with m.mu held.
+func (m *Manager) updateMetrics(now time.Time) {
+	counts := map[string]int{
+		StatePending: 0,
+		StateActive:  0,
+		StateExpired: 0,
+	}
+
+	for _, s := range m.silences {
+		counts[s.GetState(now)]++
+	}
+
+	for state, count := range counts {
+		m.metrics.SetSilences(state, count)
+	}
+}
+
+// storeKey is the key the runtime silences of the monitor are persisted under.
+func (m *Manager) storeKey() string {
+	return "silences/" + m.monitor
+}
+
+func newID() (string, error) {
+	b := make([]byte, 8)
+
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,65 @@
+package silence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/split"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/silence"
+	"github.com/ethpandaops/splitoor/pkg/monitor/store"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManager(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	st, err := store.NewStore(ctx, logrus.New(), nil)
+	require.NoError(t, err)
+
+	m, err := silence.NewManager(logrus.New(), "monitor", []silence.Silence{
+		{Group: "group-1", End: now.Add(time.Hour)},
+	}, st)
+	require.NoError(t, err)
+	require.NoError(t, m.Start(ctx))
+
+	e1 := split.NewHashInitialState(now, "monitor", "group-1", "0x123", "hash")
+	e2 := split.NewHashInitialState(now, "monitor", "group-2", "0x456", "hash")
+
+	s := m.Silenced(e1, now)
+	require.NotNil(t, s)
+	assert.Equal(t, "config-0", s.ID)
+	assert.True(t, s.Static)
+	assert.Nil(t, m.Silenced(e2, now))
+
+	added, err := m.Add(ctx, silence.Silence{Group: "group-2", End: now.Add(time.Hour)})
+	require.NoError(t, err)
+	assert.False(t, added.Start.IsZero())
+	assert.NotNil(t, m.Silenced(e2, time.Now()))
+
+	_, err = m.Add(ctx, silence.Silence{Group: "group-2"})
+	assert.Error(t, err)
+
+	// runtime silences are restored from the store, static ones come from the config
+	restored, err := silence.NewManager(logrus.New(), "monitor", nil, st)
+	require.NoError(t, err)
+	require.NoError(t, restored.Start(ctx))
+
+	list := restored.List(now, false)
+	require.Len(t, list, 1)
+	assert.Equal(t, added.ID, list[0].ID)
+
+	assert.ErrorIs(t, m.Delete(ctx, "config-0"), silence.ErrStatic)
+	assert.ErrorIs(t, m.Delete(ctx, "unknown"), silence.ErrNotFound)
+	require.NoError(t, m.Delete(ctx, added.ID))
+	assert.Nil(t, m.Silenced(e2, now))
+	assert.Len(t, m.List(now, true), 1)
+}
+
+func TestManagerInvalidStatic(t *testing.T) {
+	_, err := silence.NewManager(logrus.New(), "monitor", []silence.Silence{{Group: "group-1"}}, nil)
+	assert.Error(t, err)
+}
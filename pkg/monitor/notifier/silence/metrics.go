@@ -0,0 +1,57 @@
+package silence
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type Metrics struct {
+	silences *prometheus.GaugeVec
+	silenced *prometheus.CounterVec
+}
+
+var (
+	metricsInstance *Metrics
+	once            sync.Once
+)
+
+func GetMetricsInstance(namespace, monitor string) *Metrics {
+	once.Do(func() {
+		constLabels := prometheus.Labels{"monitor": monitor}
+
+		metricsInstance = &Metrics{
+			silences: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace:   namespace,
+					Name:        "silences",
+					Help:        "Number of silences by state",
+					ConstLabels: constLabels,
+				},
+				[]string{"state"},
+			),
+			silenced: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace:   namespace,
+					Name:        "silenced_total",
+					Help:        "Total number of events that were not published because they matched a silence",
+					ConstLabels: constLabels,
+				},
+				[]string{"group", "event_type"},
+			),
+		}
+
+		prometheus.MustRegister(metricsInstance.silences)
+		prometheus.MustRegister(metricsInstance.silenced)
+	})
+
+	return metricsInstance
+}
+
+func (m Metrics) SetSilences(state string, count int) {
+	m.silences.WithLabelValues(state).Set(float64(count))
+}
+
+func (m Metrics) IncSilenced(group, eventType string) {
+	m.silenced.WithLabelValues(group, eventType).Inc()
+}
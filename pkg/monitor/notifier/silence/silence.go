@@ -0,0 +1,99 @@
+package silence
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+)
+
+const (
+	StatePending = "pending"
+	StateActive  = "active"
+	StateExpired = "expired"
+)
+
+// Silence suppresses notifications for matching events between start and end. Every
+// matcher is optional and supports glob patterns, eg. split_hash_*. An empty matcher
+// matches everything.
+type Silence struct {
+	ID string `yaml:"id" json:"id"`
+	// Monitor matches the monitor name.
+	Monitor string `yaml:"monitor" json:"monitor,omitempty"`
+	// Group matches the group name.
+	Group string `yaml:"group" json:"group,omitempty"`
+	// EventType matches the type of the alert, resolved events and reminders are matched
+	// by the type of the alert they belong to.
+	EventType string `yaml:"eventType" json:"eventType,omitempty"`
+	// Subject matches the split address or validator pubkey.
+	Subject string `yaml:"subject" json:"subject,omitempty"`
+	// Start is when the silence starts, defaults to now.
+	Start time.Time `yaml:"start" json:"start"`
+	// End is when the silence ends.
+	End       time.Time `yaml:"end" json:"end"`
+	Comment   string    `yaml:"comment" json:"comment,omitempty"`
+	CreatedBy string    `yaml:"createdBy" json:"createdBy,omitempty"`
+	// Static is true for silences from the config file, they can't be deleted at runtime.
+	Static bool `yaml:"-" json:"static"`
+}
+
+func (s *Silence) Validate() error {
+	if s.End.IsZero() {
+		return errors.New("silence end is required")
+	}
+
+	if !s.End.After(s.Start) {
+		return errors.New("silence end must be after start")
+	}
+
+	for name, pattern := range map[string]string{
+		"monitor":   s.Monitor,
+		"group":     s.Group,
+		"eventType": s.EventType,
+		"subject":   s.Subject,
+	} {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("silence %s pattern %q is invalid: %w", name, pattern, err)
+		}
+	}
+
+	return nil
+}
+
+// GetState returns whether the silence is pending, active or expired at the given time.
+func (s *Silence) GetState(now time.Time) string {
+	if now.Before(s.Start) {
+		return StatePending
+	}
+
+	if !now.Before(s.End) {
+		return StateExpired
+	}
+
+	return StateActive
+}
+
+// Matches returns true if the silence is active and matches the event.
+func (s *Silence) Matches(e event.Event, now time.Time) bool {
+	if s.GetState(now) != StateActive {
+		return false
+	}
+
+	return match(s.Monitor, e.GetMonitor()) &&
+		match(s.Group, e.GetGroup()) &&
+		match(s.EventType, event.GetAlertType(e)) &&
+		match(s.Subject, event.GetSubject(e))
+}
+
+func match(pattern, value string) bool {
+	if pattern == "" {
+		return true
+	}
+
+	ok, err := path.Match(strings.ToLower(pattern), strings.ToLower(value))
+
+	return err == nil && ok
+}
@@ -0,0 +1,143 @@
+package silence_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/split"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/validator"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/silence"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSilenceValidate(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name        string
+		silence     silence.Silence
+		expectError bool
+	}{
+		{
+			name:    "valid",
+			silence: silence.Silence{Group: "group", End: now.Add(time.Hour)},
+		},
+		{
+			name:        "missing end",
+			silence:     silence.Silence{Group: "group"},
+			expectError: true,
+		},
+		{
+			name:        "end before start",
+			silence:     silence.Silence{Start: now, End: now.Add(-time.Hour)},
+			expectError: true,
+		},
+		{
+			name:        "invalid pattern",
+			silence:     silence.Silence{EventType: "split_[", End: now.Add(time.Hour)},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.silence.Validate()
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSilenceGetState(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	s := &silence.Silence{Start: start, End: start.Add(time.Hour)}
+
+	assert.Equal(t, silence.StatePending, s.GetState(start.Add(-time.Minute)))
+	assert.Equal(t, silence.StateActive, s.GetState(start))
+	assert.Equal(t, silence.StateActive, s.GetState(start.Add(59*time.Minute)))
+	assert.Equal(t, silence.StateExpired, s.GetState(start.Add(time.Hour)))
+}
+
+func TestSilenceMatches(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	now := start.Add(time.Minute)
+
+	hashEvent := split.NewHashRecoveryState(now, "monitor", "group-1", "0xABC", "hash")
+	validatorEvent := validator.NewStatus(now, "exited_unslashed", "0xdef", "validators", "monitor")
+
+	tests := []struct {
+		name    string
+		silence silence.Silence
+		event   event.Event
+		want    bool
+	}{
+		{
+			name:    "empty matchers match everything",
+			silence: silence.Silence{},
+			event:   hashEvent,
+			want:    true,
+		},
+		{
+			name:    "group",
+			silence: silence.Silence{Group: "group-1"},
+			event:   hashEvent,
+			want:    true,
+		},
+		{
+			name:    "other group",
+			silence: silence.Silence{Group: "group-2"},
+			event:   hashEvent,
+			want:    false,
+		},
+		{
+			name:    "event type glob",
+			silence: silence.Silence{EventType: "split_hash_*"},
+			event:   hashEvent,
+			want:    true,
+		},
+		{
+			name:    "subject is case insensitive",
+			silence: silence.Silence{Subject: "0xabc"},
+			event:   hashEvent,
+			want:    true,
+		},
+		{
+			name:    "resolved event matches alert type",
+			silence: silence.Silence{EventType: split.HashRecoveryStateType},
+			event:   event.NewResolved(now, start, "monitor", "group-1", split.HashRecoveryStateType, split.HashRecoveryStateSeverity, "title", "0xabc"),
+			want:    true,
+		},
+		{
+			name:    "reminder matches alert type",
+			silence: silence.Silence{EventType: split.HashRecoveryStateType},
+			event:   event.NewReminder(now, start, hashEvent),
+			want:    true,
+		},
+		{
+			name:    "all matchers",
+			silence: silence.Silence{Monitor: "monitor", Group: "validators", EventType: validator.StatusType, Subject: "0xdef"},
+			event:   validatorEvent,
+			want:    true,
+		},
+		{
+			name:    "other monitor",
+			silence: silence.Silence{Monitor: "other"},
+			event:   validatorEvent,
+			want:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.silence.Start = start
+			tt.silence.End = start.Add(time.Hour)
+
+			assert.Equal(t, tt.want, tt.silence.Matches(tt.event, now))
+			assert.False(t, tt.silence.Matches(tt.event, start.Add(2*time.Hour)))
+		})
+	}
+}
@@ -0,0 +1,271 @@
+package alertmanager
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/safe"
+	"github.com/sirupsen/logrus"
+)
+
+const SourceType = "alertmanager"
+
+// Alert is a postable alert of the alertmanager v2 api.
+type Alert struct {
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations"`
+	StartsAt     time.Time         `json:"startsAt"`
+	EndsAt       *time.Time        `json:"endsAt,omitempty"`
+	GeneratorURL string            `json:"generatorURL,omitempty"`
+}
+
+type Alertmanager struct {
+	log     logrus.FieldLogger
+	name    string
+	config  *Config
+	client  *http.Client
+	metrics *Metrics
+
+	includeMonitorName bool
+	includeGroupName   bool
+	docs               *string
+
+	// firing alerts keyed by monitor/group/type/subject, re-posted until resolved
+	firing map[string]*Alert
+	mu     sync.Mutex
+
+	cancel context.CancelFunc
+}
+
+func NewAlertmanager(ctx context.Context, log logrus.FieldLogger, monitor, name string, docs *string, includeMonitorName, includeGroupName bool, config *Config) (*Alertmanager, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Alertmanager{
+		log:                log.WithField("source", name),
+		name:               name,
+		config:             config,
+		client:             &http.Client{Timeout: config.Timeout},
+		metrics:            GetMetricsInstance("splitoor_notifier_alertmanager", monitor),
+		includeMonitorName: includeMonitorName,
+		includeGroupName:   includeGroupName,
+		docs:               docs,
+		firing:             make(map[string]*Alert),
+	}, nil
+}
+
+func (c *Alertmanager) Start(ctx context.Context) error {
+	ctx, c.cancel = context.WithCancel(ctx)
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(c.config.ResendInterval):
+				c.resend(ctx)
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (c *Alertmanager) Stop(ctx context.Context) error {
+	if c.cancel != nil {
+		c.cancel()
+	}
+
+	return nil
+}
+
+func (c *Alertmanager) GetType() string {
+	return SourceType
+}
+
+func (c *Alertmanager) GetName() string {
+	return c.name
+}
+
+func (c *Alertmanager) GetConfig() *Config {
+	return c.config
+}
+
+// Firing returns the number of alerts currently being re-posted.
+func (c *Alertmanager) Firing() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.firing)
+}
+
+func (c *Alertmanager) Publish(ctx context.Context, e event.Event) error {
+	key := strings.Join([]string{e.GetMonitor(), e.GetGroup(), event.GetAlertType(e), event.GetSubject(e)}, "/")
+
+	c.mu.Lock()
+
+	var alert *Alert
+
+	if event.IsResolved(e) {
+		alert = c.firing[key]
+		if alert == nil {
+			alert = c.alert(e)
+		}
+
+		endsAt := time.Now().UTC()
+
+		resolved := *alert
+		resolved.EndsAt = &endsAt
+		alert = &resolved
+
+		delete(c.firing, key)
+	} else {
+		alert = c.alert(e)
+		c.firing[key] = alert
+	}
+
+	c.mu.Unlock()
+
+	return c.post(ctx, e.GetGroup(), []*Alert{alert})
+}
+
+func (c *Alertmanager) resend(ctx context.Context) {
+	c.mu.Lock()
+
+	byGroup := make(map[string][]*Alert)
+
+	for _, alert := range c.firing {
+		group := alert.Labels["group"]
+		byGroup[group] = append(byGroup[group], alert)
+	}
+
+	c.mu.Unlock()
+
+	for group, alerts := range byGroup {
+		if err := c.post(ctx, group, alerts); err != nil {
+			c.log.WithError(err).WithField("group", group).Error("Error re-posting firing alerts")
+		}
+	}
+}
+
+func (c *Alertmanager) post(ctx context.Context, group string, alerts []*Alert) error {
+	log := c.log.WithField("group", group)
+	log.Debug("Posting alerts to Alertmanager")
+
+	var errorType string
+
+	var statusCode string
+
+	defer func() {
+		if errorType != "" {
+			c.metrics.IncErrors(group, c.name, c.GetType(), errorType, statusCode)
+		} else {
+			c.metrics.IncMessagesPublished(group, c.name, c.GetType())
+		}
+	}()
+
+	jsonData, err := json.Marshal(alerts)
+	if err != nil {
+		errorType = "marshal_error"
+
+		return fmt.Errorf("failed to marshal alertmanager alerts: %w", err)
+	}
+
+	endpoint := strings.TrimSuffix(c.config.URL, "/") + "/api/v2/alerts"
+
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewBuffer(jsonData))
+	if err != nil {
+		errorType = "request_error"
+
+		return fmt.Errorf("failed to create alertmanager request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	if c.config.Username != "" {
+		req.SetBasicAuth(c.config.Username, c.config.Password)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		errorType = "send_error"
+
+		return fmt.Errorf("failed to send alertmanager alerts: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		statusCode = strconv.Itoa(resp.StatusCode)
+		errorType = "status_error"
+
+		log.WithField("status_code", resp.StatusCode).Error("Alertmanager returned non-2xx status code")
+
+		return fmt.Errorf("alertmanager returned non-2xx status code: %d", resp.StatusCode)
+	}
+
+	log.Debug("Successfully posted alerts to Alertmanager")
+
+	return nil
+}
+
+func (c *Alertmanager) alert(e event.Event) *Alert {
+	alertType := event.GetAlertType(e)
+
+	labels := map[string]string{}
+
+	for key, value := range c.config.Labels {
+		labels[key] = value
+	}
+
+	labels["alertname"] = alertType
+	labels["type"] = alertType
+	labels["monitor"] = e.GetMonitor()
+	labels["group"] = e.GetGroup()
+
+	if subject := event.GetSubject(e); subject != "" {
+		labels[SubjectLabel(alertType)] = subject
+	}
+
+	alert := &Alert{
+		Labels: labels,
+		Annotations: map[string]string{
+			"title":       e.GetTitle(c.includeMonitorName, c.includeGroupName),
+			"description": e.GetDescriptionText(c.includeMonitorName, c.includeGroupName),
+		},
+		StartsAt: time.Now().UTC(),
+	}
+
+	if c.docs != nil {
+		docURL := strings.ReplaceAll(*c.docs, ":group", url.QueryEscape(e.GetGroup()))
+
+		alert.Annotations["docs"] = docURL
+		alert.GeneratorURL = docURL
+	}
+
+	return alert
+}
+
+// SubjectLabel returns the label name used for the subject of an alert type.
+func SubjectLabel(alertType string) string {
+	switch {
+	case strings.HasPrefix(alertType, "split_"):
+		return "split"
+	case strings.HasPrefix(alertType, "safe_"), alertType == safe.SignerMismatchType:
+		return "safe"
+	case strings.HasPrefix(alertType, "validator_"):
+		return "pubkey"
+	}
+
+	return "subject"
+}
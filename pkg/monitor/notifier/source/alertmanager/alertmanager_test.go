@@ -0,0 +1,157 @@
+package alertmanager_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/safe"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/split"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/alertmanager"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recorder struct {
+	requests [][]*alertmanager.Alert
+	mu       sync.Mutex
+}
+
+func (r *recorder) handler(t *testing.T, status int) http.HandlerFunc {
+	t.Helper()
+
+	return func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/api/v2/alerts", req.URL.Path)
+
+		user, pass, ok := req.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "user", user)
+		assert.Equal(t, "pass", pass)
+
+		var alerts []*alertmanager.Alert
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&alerts))
+
+		r.mu.Lock()
+		r.requests = append(r.requests, alerts)
+		r.mu.Unlock()
+
+		w.WriteHeader(status)
+	}
+}
+
+func (r *recorder) get() [][]*alertmanager.Alert {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.requests
+}
+
+func newAlertmanager(t *testing.T, url string, resend time.Duration) *alertmanager.Alertmanager {
+	t.Helper()
+
+	docs := "https://docs.example.com/:group"
+
+	am, err := alertmanager.NewAlertmanager(context.Background(), logrus.New(), "monitor", "test_source", &docs, true, true, &alertmanager.Config{
+		URL:            url,
+		Username:       "user",
+		Password:       "pass",
+		Labels:         map[string]string{"team": "staking"},
+		ResendInterval: resend,
+		Timeout:        time.Second,
+	})
+	require.NoError(t, err)
+
+	return am
+}
+
+func TestAlertmanagerPublish(t *testing.T) {
+	rec := &recorder{}
+
+	server := httptest.NewServer(rec.handler(t, http.StatusOK))
+	defer server.Close()
+
+	am := newAlertmanager(t, server.URL, time.Hour)
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	require.NoError(t, am.Publish(context.Background(), split.NewHashUnknownState(now, "monitor", "group", "0x123", "expected", "actual")))
+	assert.Equal(t, 1, am.Firing())
+
+	require.NoError(t, am.Publish(context.Background(), event.NewResolved(now, now, "monitor", "group", split.HashUnknownStateType, split.HashUnknownStateSeverity, "Split hash is in unknown state", "0x123")))
+	assert.Equal(t, 0, am.Firing())
+
+	requests := rec.get()
+	require.Len(t, requests, 2)
+
+	firing := requests[0][0]
+	assert.Equal(t, map[string]string{
+		"alertname": split.HashUnknownStateType,
+		"type":      split.HashUnknownStateType,
+		"monitor":   "monitor",
+		"group":     "group",
+		"split":     "0x123",
+		"team":      "staking",
+	}, firing.Labels)
+	assert.Equal(t, "https://docs.example.com/group", firing.Annotations["docs"])
+	assert.Nil(t, firing.EndsAt)
+
+	resolved := requests[1][0]
+	assert.Equal(t, firing.Labels, resolved.Labels)
+	assert.NotNil(t, resolved.EndsAt)
+}
+
+func TestAlertmanagerResend(t *testing.T) {
+	rec := &recorder{}
+
+	server := httptest.NewServer(rec.handler(t, http.StatusOK))
+	defer server.Close()
+
+	am := newAlertmanager(t, server.URL, 10*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	require.NoError(t, am.Start(ctx))
+
+	require.NoError(t, am.Publish(ctx, safe.NewRecoveryTransactionMissing(time.Now(), "monitor", "group", "0xsafe")))
+
+	assert.Eventually(t, func() bool {
+		return len(rec.get()) >= 3
+	}, time.Second, 10*time.Millisecond)
+
+	require.NoError(t, am.Stop(ctx))
+
+	for _, alerts := range rec.get() {
+		require.Len(t, alerts, 1)
+		assert.Equal(t, "0xsafe", alerts[0].Labels["safe"])
+	}
+}
+
+func TestAlertmanagerPublishError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	am := newAlertmanager(t, server.URL, time.Hour)
+
+	err := am.Publish(context.Background(), split.NewController(time.Now(), "monitor", "group", "0x123", "0x456", "0x789"))
+	assert.Error(t, err)
+
+	// still re-posted until resolved
+	assert.Equal(t, 1, am.Firing())
+}
+
+func TestSubjectLabel(t *testing.T) {
+	assert.Equal(t, "split", alertmanager.SubjectLabel(split.ControllerType))
+	assert.Equal(t, "safe", alertmanager.SubjectLabel(safe.SignerMismatchType))
+	assert.Equal(t, "safe", alertmanager.SubjectLabel(safe.TransactionQueueExcessType))
+	assert.Equal(t, "pubkey", alertmanager.SubjectLabel("validator_status"))
+	assert.Equal(t, "subject", alertmanager.SubjectLabel("other"))
+}
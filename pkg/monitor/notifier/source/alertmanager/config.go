@@ -0,0 +1,41 @@
+package alertmanager
+
+import (
+	"errors"
+	"net/url"
+	"time"
+)
+
+type Config struct {
+	// URL is the base url of alertmanager, eg. http://alertmanager:9093
+	URL string `yaml:"url"`
+	// Username and Password enable basic auth.
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+	// Labels are added to every alert.
+	Labels map[string]string `yaml:"labels"`
+	// ResendInterval is how often firing alerts are re-posted so they don't expire.
+	// Should be lower than the alertmanager resolve_timeout.
+	ResendInterval time.Duration `yaml:"resendInterval" default:"1m"`
+	Timeout        time.Duration `yaml:"timeout" default:"10s"`
+}
+
+func (c *Config) Validate() error {
+	if c.URL == "" {
+		return errors.New("url is required")
+	}
+
+	if _, err := url.ParseRequestURI(c.URL); err != nil {
+		return errors.New("url is invalid")
+	}
+
+	if c.ResendInterval <= 0 {
+		return errors.New("resendInterval must be greater than 0")
+	}
+
+	if c.Timeout <= 0 {
+		return errors.New("timeout must be greater than 0")
+	}
+
+	return nil
+}
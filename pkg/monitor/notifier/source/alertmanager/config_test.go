@@ -0,0 +1,62 @@
+package alertmanager_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/alertmanager"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      *alertmanager.Config
+		expectError bool
+	}{
+		{
+			name: "valid config",
+			config: &alertmanager.Config{
+				URL:            "http://alertmanager:9093",
+				ResendInterval: time.Minute,
+				Timeout:        10 * time.Second,
+			},
+			expectError: false,
+		},
+		{
+			name: "missing url",
+			config: &alertmanager.Config{
+				ResendInterval: time.Minute,
+				Timeout:        10 * time.Second,
+			},
+			expectError: true,
+		},
+		{
+			name: "zero resend interval",
+			config: &alertmanager.Config{
+				URL:     "http://alertmanager:9093",
+				Timeout: 10 * time.Second,
+			},
+			expectError: true,
+		},
+		{
+			name: "zero timeout",
+			config: &alertmanager.Config{
+				URL:            "http://alertmanager:9093",
+				ResendInterval: time.Minute,
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -1,6 +1,13 @@
 package source
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/templates"
+)
 
 type Config struct {
 	SourceType         SourceType  `yaml:"type"`
@@ -8,22 +15,84 @@ type Config struct {
 	Group              *string     `yaml:"group,omitempty"`
 	IncludeMonitorName bool        `yaml:"includeMonitorName"`
 	Config             *RawMessage `yaml:"config"`
+	// MinSeverity is the minimum severity of events sent to the source.
+	MinSeverity *event.Severity `yaml:"minSeverity,omitempty"`
+	// Events only sends these event types to the source, eg. split_controller.
+	Events []string `yaml:"events,omitempty"`
+	// ExcludeEvents never sends these event types to the source.
+	ExcludeEvents []string `yaml:"excludeEvents,omitempty"`
+	// Templates override how events are rendered by event type, eg. split_controller.
+	Templates map[string]templates.Config `yaml:"templates,omitempty"`
 }
 
 type SourceType string
 
 const (
-	SourceTypeUnknown  SourceType = "unknown"
-	SourceTypeDiscord  SourceType = "discord"
-	SourceTypeSMTP     SourceType = "smtp"
-	SourceTypeSES      SourceType = "ses"
-	SourceTypeTelegram SourceType = "telegram"
+	SourceTypeUnknown      SourceType = "unknown"
+	SourceTypeDiscord      SourceType = "discord"
+	SourceTypeSMTP         SourceType = "smtp"
+	SourceTypeSES          SourceType = "ses"
+	SourceTypeTelegram     SourceType = "telegram"
+	SourceTypeWebhook      SourceType = "webhook"
+	SourceTypeSlack        SourceType = "slack"
+	SourceTypePagerDuty    SourceType = "pagerduty"
+	SourceTypeAlertmanager SourceType = "alertmanager"
+	SourceTypeOpsgenie     SourceType = "opsgenie"
+	SourceTypeMatrix       SourceType = "matrix"
+	SourceTypeTeams        SourceType = "teams"
+	SourceTypeNtfy         SourceType = "ntfy"
+	SourceTypeGotify       SourceType = "gotify"
+	SourceTypePushover     SourceType = "pushover"
 )
 
+// Pages returns true for sources that open an incident for every alert, which stays open
+// until the alert is resolved.
+func (t SourceType) Pages() bool {
+	return t == SourceTypePagerDuty || t == SourceTypeOpsgenie
+}
+
 func (c *Config) Validate() error {
 	if c.SourceType == SourceTypeUnknown {
 		return errors.New("notifier source type is required")
 	}
 
+	if c.MinSeverity != nil {
+		if _, err := event.ParseSeverity(string(*c.MinSeverity)); err != nil {
+			return fmt.Errorf("notifier source %s: %w", c.Name, err)
+		}
+	}
+
+	for _, eventType := range c.Events {
+		if slices.Contains(c.ExcludeEvents, eventType) {
+			return fmt.Errorf("notifier source %s: event type %s is both included and excluded", c.Name, eventType)
+		}
+	}
+
 	return nil
 }
+
+// Matches returns true if the event should be sent to the source. Resolved events are
+// matched on the type and severity of the alert they resolve, so they follow the alert.
+// Notices are never resolved, paging sources only receive them when they are listed in
+// Events.
+func (c *Config) Matches(e event.Event) bool {
+	if c.Group != nil && e.GetGroup() != *c.Group {
+		return false
+	}
+
+	if c.MinSeverity != nil && !e.GetSeverity().AtLeast(*c.MinSeverity) {
+		return false
+	}
+
+	alertType := event.GetAlertType(e)
+
+	if c.SourceType.Pages() && event.IsNotice(e) && !slices.Contains(c.Events, alertType) {
+		return false
+	}
+
+	if len(c.Events) > 0 && !slices.Contains(c.Events, alertType) {
+		return false
+	}
+
+	return !slices.Contains(c.ExcludeEvents, alertType)
+}
@@ -0,0 +1,156 @@
+package source_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/split"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source"
+	"github.com/stretchr/testify/assert"
+)
+
+func severity(s event.Severity) *event.Severity {
+	return &s
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      *source.Config
+		expectError bool
+	}{
+		{
+			name: "valid config",
+			config: &source.Config{
+				SourceType:    source.SourceTypeDiscord,
+				MinSeverity:   severity(event.SeverityWarning),
+				Events:        []string{split.ControllerType},
+				ExcludeEvents: []string{split.HashInitialStateType},
+			},
+		},
+		{
+			name:        "unknown source type",
+			config:      &source.Config{SourceType: source.SourceTypeUnknown},
+			expectError: true,
+		},
+		{
+			name: "invalid min severity",
+			config: &source.Config{
+				SourceType:  source.SourceTypeDiscord,
+				MinSeverity: severity("urgent"),
+			},
+			expectError: true,
+		},
+		{
+			name: "event type included and excluded",
+			config: &source.Config{
+				SourceType:    source.SourceTypeDiscord,
+				Events:        []string{split.ControllerType},
+				ExcludeEvents: []string{split.ControllerType},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestConfigMatches(t *testing.T) {
+	now := time.Now()
+	group := "group-1"
+
+	controller := split.NewController(now, "monitor", "group-1", "0x1", "0x2", "0x3")
+	initial := split.NewHashInitialState(now, "monitor", "group-1", "0x1", "hash")
+	contractEvent := split.NewContractEvent(now, "monitor", "group-1", "UpdateSplit", "0x1", "0xtx", 1, "0x2")
+	controllerResolved := event.NewResolved(now, now, "monitor", "group-1", split.ControllerType, split.ControllerSeverity, "title", "0x1")
+
+	tests := []struct {
+		name   string
+		config *source.Config
+		event  event.Event
+		want   bool
+	}{
+		{
+			name:   "no filters",
+			config: &source.Config{},
+			event:  initial,
+			want:   true,
+		},
+		{
+			name:   "other group",
+			config: &source.Config{Group: &group},
+			event:  split.NewHashInitialState(now, "monitor", "group-2", "0x1", "hash"),
+			want:   false,
+		},
+		{
+			name:   "below min severity",
+			config: &source.Config{MinSeverity: severity(event.SeverityCritical)},
+			event:  initial,
+			want:   false,
+		},
+		{
+			name:   "at min severity",
+			config: &source.Config{MinSeverity: severity(event.SeverityCritical)},
+			event:  controller,
+			want:   true,
+		},
+		{
+			name:   "resolved follows alert severity",
+			config: &source.Config{MinSeverity: severity(event.SeverityCritical)},
+			event:  controllerResolved,
+			want:   true,
+		},
+		{
+			name:   "not in allow list",
+			config: &source.Config{Events: []string{split.ControllerType}},
+			event:  initial,
+			want:   false,
+		},
+		{
+			name:   "resolved in allow list",
+			config: &source.Config{Events: []string{split.ControllerType}},
+			event:  controllerResolved,
+			want:   true,
+		},
+		{
+			name:   "in deny list",
+			config: &source.Config{ExcludeEvents: []string{split.HashInitialStateType}},
+			event:  initial,
+			want:   false,
+		},
+		{
+			name:   "notice to chat source",
+			config: &source.Config{SourceType: source.SourceTypeSlack},
+			event:  contractEvent,
+			want:   true,
+		},
+		{
+			name:   "notice to paging source",
+			config: &source.Config{SourceType: source.SourceTypePagerDuty},
+			event:  contractEvent,
+			want:   false,
+		},
+		{
+			name:   "notice in paging source allow list",
+			config: &source.Config{SourceType: source.SourceTypeOpsgenie, Events: []string{split.ContractEventUpdateSplitType}},
+			event:  contractEvent,
+			want:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.config.Matches(tt.event))
+		})
+	}
+}
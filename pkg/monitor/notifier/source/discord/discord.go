@@ -16,6 +16,13 @@ import (
 
 const SourceType = "discord"
 
+// severityColors are the embed colors of alerts by severity.
+var severityColors = map[event.Severity]int{
+	event.SeverityInfo:     3447003,  // blue
+	event.SeverityWarning:  16753920, // orange
+	event.SeverityCritical: 16711680, // red
+}
+
 type Discord struct {
 	log     logrus.FieldLogger
 	name    string
@@ -82,13 +89,24 @@ func (c *Discord) Publish(ctx context.Context, e event.Event) error {
 		description = fmt.Sprintf("%s\n\n[**Go to docs**](%s)", description, docURL)
 	}
 
+	emoji := event.EmojiPrefix(e)
+	color := severityColors[e.GetSeverity()]
+
+	if color == 0 {
+		color = severityColors[event.SeverityCritical]
+	}
+
+	if event.IsResolved(e) {
+		color = 65280
+	}
+
 	message := map[string]interface{}{
 		"username": "Splitoor",
 		"embeds": []map[string]interface{}{
 			{
-				"title":       fmt.Sprintf("🚨 %s", e.GetTitle(c.includeMonitorName, c.includeGroupName)),
+				"title":       fmt.Sprintf("%s%s", emoji, e.GetTitle(c.includeMonitorName, c.includeGroupName)),
 				"description": description,
-				"color":       16711680,
+				"color":       color,
 			},
 		},
 	}
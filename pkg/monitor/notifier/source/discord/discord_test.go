@@ -6,6 +6,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
 	disc "github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/discord"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
@@ -58,6 +59,18 @@ func (m *MockEvent) GetDescriptionHTML(includeMonitorName, includeGroupName bool
 	return args.String(0)
 }
 
+func (m *MockEvent) GetSeverity() event.Severity {
+	args := m.Called()
+
+	return args.Get(0).(event.Severity)
+}
+
+func (m *MockEvent) GetPayload() *event.Payload {
+	args := m.Called()
+
+	return args.Get(0).(*event.Payload)
+}
+
 func TestNewDiscord(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -137,6 +150,7 @@ func TestDiscordPublish(t *testing.T) {
 			mockEvent.On("GetGroup").Return("test_group")
 			mockEvent.On("GetTitle", true, true).Return("Test Title")
 			mockEvent.On("GetDescriptionMarkdown", true, true).Return("Test Description")
+			mockEvent.On("GetSeverity").Return(event.SeverityWarning)
 
 			err = discord.Publish(context.Background(), mockEvent)
 
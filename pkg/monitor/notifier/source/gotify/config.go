@@ -0,0 +1,39 @@
+package gotify
+
+import (
+	"errors"
+	"fmt"
+)
+
+type Config struct {
+	// Server is the url of the gotify server.
+	Server string `yaml:"server"`
+	// Token is the token of a gotify application.
+	Token string `yaml:"token"`
+	// Priorities overrides the priority (0-10) for an event type, eg. split_hash_initial_state: 2
+	Priorities map[string]int `yaml:"priorities"`
+	// ResolvedPriority is the priority of resolved notifications.
+	ResolvedPriority *int `yaml:"resolvedPriority" default:"2"`
+}
+
+func (c *Config) Validate() error {
+	if c.Server == "" {
+		return errors.New("server is required")
+	}
+
+	if c.Token == "" {
+		return errors.New("token is required")
+	}
+
+	for eventType, priority := range c.Priorities {
+		if !isValidPriority(priority) {
+			return fmt.Errorf("invalid priority %d for event type %s, must be between %d and %d", priority, eventType, PriorityMin, PriorityMax)
+		}
+	}
+
+	if c.ResolvedPriority != nil && !isValidPriority(*c.ResolvedPriority) {
+		return fmt.Errorf("invalid resolved priority %d, must be between %d and %d", *c.ResolvedPriority, PriorityMin, PriorityMax)
+	}
+
+	return nil
+}
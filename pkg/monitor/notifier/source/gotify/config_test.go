@@ -0,0 +1,60 @@
+package gotify_test
+
+import (
+	"testing"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/gotify"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      *gotify.Config
+		expectError bool
+	}{
+		{
+			name: "valid config",
+			config: &gotify.Config{
+				Server:     "https://gotify.example.com",
+				Token:      "token",
+				Priorities: map[string]int{"split_hash_initial_state": 2},
+			},
+			expectError: false,
+		},
+		{
+			name: "missing server",
+			config: &gotify.Config{
+				Token: "token",
+			},
+			expectError: true,
+		},
+		{
+			name: "missing token",
+			config: &gotify.Config{
+				Server: "https://gotify.example.com",
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid priority",
+			config: &gotify.Config{
+				Server:     "https://gotify.example.com",
+				Token:      "token",
+				Priorities: map[string]int{"split_controller": 11},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package gotify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/sirupsen/logrus"
+)
+
+const SourceType = "gotify"
+
+type Gotify struct {
+	log     logrus.FieldLogger
+	name    string
+	config  *Config
+	client  *http.Client
+	metrics *Metrics
+
+	includeMonitorName bool
+	includeGroupName   bool
+	docs               *string
+}
+
+// Message is the body of a create message request.
+type Message struct {
+	Title    string                 `json:"title"`
+	Message  string                 `json:"message"`
+	Priority int                    `json:"priority"`
+	Extras   map[string]interface{} `json:"extras,omitempty"`
+}
+
+func NewGotify(ctx context.Context, log logrus.FieldLogger, monitor, name string, docs *string, includeMonitorName, includeGroupName bool, config *Config) (*Gotify, error) {
+	return &Gotify{
+		log:                log.WithField("source", name),
+		name:               name,
+		config:             config,
+		client:             &http.Client{},
+		metrics:            GetMetricsInstance("splitoor_notifier_gotify", monitor),
+		includeMonitorName: includeMonitorName,
+		includeGroupName:   includeGroupName,
+		docs:               docs,
+	}, nil
+}
+
+func (c *Gotify) Start(ctx context.Context) error {
+	return nil
+}
+
+func (c *Gotify) Stop(ctx context.Context) error {
+	return nil
+}
+
+func (c *Gotify) GetType() string {
+	return SourceType
+}
+
+func (c *Gotify) GetName() string {
+	return c.name
+}
+
+func (c *Gotify) GetConfig() *Config {
+	return c.config
+}
+
+func (c *Gotify) Publish(ctx context.Context, e event.Event) error {
+	log := c.log.WithField("group", e.GetGroup())
+	log.Debug("Publishing message to Gotify")
+
+	var errorType string
+
+	var statusCode string
+
+	defer func() {
+		if errorType != "" {
+			c.metrics.IncErrors(e.GetGroup(), c.name, c.GetType(), errorType, statusCode)
+		} else {
+			c.metrics.IncMessagesPublished(e.GetGroup(), c.name, c.GetType())
+		}
+	}()
+
+	emoji := event.EmojiPrefix(e)
+
+	message := &Message{
+		Title:    fmt.Sprintf("%s%s", emoji, e.GetTitle(c.includeMonitorName, c.includeGroupName)),
+		Message:  e.GetDescriptionText(c.includeMonitorName, c.includeGroupName),
+		Priority: GetPriority(e, c.config.Priorities, c.config.ResolvedPriority),
+	}
+
+	if c.docs != nil {
+		message.Extras = map[string]interface{}{
+			"client::notification": map[string]interface{}{
+				"click": map[string]string{
+					"url": strings.ReplaceAll(*c.docs, ":group", url.QueryEscape(e.GetGroup())),
+				},
+			},
+		}
+	}
+
+	jsonData, err := json.Marshal(message)
+	if err != nil {
+		errorType = "marshal_error"
+
+		return fmt.Errorf("failed to marshal gotify message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", strings.TrimSuffix(c.config.Server, "/")+"/message", bytes.NewBuffer(jsonData))
+	if err != nil {
+		errorType = "request_error"
+
+		return fmt.Errorf("failed to create gotify request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Gotify-Key", c.config.Token)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		errorType = "send_error"
+
+		return fmt.Errorf("failed to send gotify message: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		statusCode = strconv.Itoa(resp.StatusCode)
+		errorType = "status_error"
+
+		log.WithField("status_code", resp.StatusCode).Error("Gotify returned non-200 status code")
+
+		return fmt.Errorf("gotify returned non-200 status code: %d", resp.StatusCode)
+	}
+
+	log.Debug("Successfully published message to Gotify")
+
+	return nil
+}
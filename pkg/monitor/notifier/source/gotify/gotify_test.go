@@ -0,0 +1,90 @@
+package gotify_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/split"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/gotify"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGotifyPublish(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	docs := "https://docs.example.com/:group"
+	resolvedPriority := gotify.PriorityLow
+
+	tests := []struct {
+		name           string
+		event          event.Event
+		serverResponse int
+		wantPriority   int
+		expectError    bool
+	}{
+		{
+			name:           "alert",
+			event:          split.NewHashUnknownState(now, "monitor", "group", "0x123", "expected", "actual"),
+			serverResponse: http.StatusOK,
+			wantPriority:   gotify.PriorityMax,
+		},
+		{
+			name:           "resolved",
+			event:          event.NewResolved(now, now.Add(-time.Hour), "monitor", "group", split.HashUnknownStateType, split.HashUnknownStateSeverity, "Split hash is in unknown state", "0x123"),
+			serverResponse: http.StatusOK,
+			wantPriority:   gotify.PriorityLow,
+		},
+		{
+			name:           "unauthorized",
+			event:          split.NewHashUnknownState(now, "monitor", "group", "0x123", "expected", "actual"),
+			serverResponse: http.StatusUnauthorized,
+			wantPriority:   gotify.PriorityMax,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/message", r.URL.Path)
+				assert.Equal(t, "token", r.Header.Get("X-Gotify-Key"))
+
+				var message gotify.Message
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&message))
+
+				assert.Contains(t, message.Title, tt.event.GetTitle(true, true))
+				assert.Equal(t, tt.event.GetDescriptionText(true, true), message.Message)
+				assert.Equal(t, tt.wantPriority, message.Priority)
+				assert.Equal(t, map[string]interface{}{
+					"client::notification": map[string]interface{}{
+						"click": map[string]interface{}{"url": "https://docs.example.com/group"},
+					},
+				}, message.Extras)
+
+				w.WriteHeader(tt.serverResponse)
+			}))
+			defer server.Close()
+
+			source, err := gotify.NewGotify(context.Background(), logrus.New(), "monitor", "test_source", &docs, true, true, &gotify.Config{
+				Server:           server.URL + "/",
+				Token:            "token",
+				ResolvedPriority: &resolvedPriority,
+			})
+			require.NoError(t, err)
+
+			err = source.Publish(context.Background(), tt.event)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package gotify
+
+import "github.com/ethpandaops/splitoor/pkg/monitor/event"
+
+// gotify priorities range from 0 to 10, the android app plays a sound from 4 and
+// shows a heads-up notification from 8.
+const (
+	PriorityMin     = 0
+	PriorityLow     = 2
+	PriorityDefault = 5
+	PriorityHigh    = 8
+	PriorityMax     = 10
+)
+
+func isValidPriority(priority int) bool {
+	return priority >= PriorityMin && priority <= PriorityMax
+}
+
+// GetPriority returns the gotify priority of an event, derived from the severity of the
+// event unless its type is overridden in config.
+func GetPriority(e event.Event, overrides map[string]int, resolved *int) int {
+	if event.IsResolved(e) && resolved != nil {
+		return *resolved
+	}
+
+	if priority, ok := overrides[event.GetAlertType(e)]; ok {
+		return priority
+	}
+
+	switch e.GetSeverity() {
+	case event.SeverityCritical:
+		return PriorityMax
+	case event.SeverityInfo:
+		return PriorityDefault
+	}
+
+	return PriorityHigh
+}
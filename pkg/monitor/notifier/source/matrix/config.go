@@ -0,0 +1,42 @@
+package matrix
+
+import (
+	"errors"
+	"strings"
+)
+
+type Config struct {
+	// Homeserver is the base url of the matrix homeserver, eg. https://matrix.org
+	Homeserver  string `yaml:"homeserver"`
+	AccessToken string `yaml:"accessToken"`
+	// RoomID is the internal room id, eg. !abcdefg:matrix.org
+	RoomID string `yaml:"roomId"`
+	// ThreadID is the event id of a thread root, all messages are posted to this thread if set.
+	ThreadID string `yaml:"threadId,omitempty"`
+	// ReplyToAlert posts resolved messages as a reply to the message of the alert they resolve.
+	ReplyToAlert bool `yaml:"replyToAlert" default:"true"`
+}
+
+func (c *Config) Validate() error {
+	if c.Homeserver == "" {
+		return errors.New("matrix homeserver is required")
+	}
+
+	if c.AccessToken == "" {
+		return errors.New("matrix access token is required")
+	}
+
+	if c.RoomID == "" {
+		return errors.New("matrix room id is required")
+	}
+
+	if !strings.HasPrefix(c.RoomID, "!") {
+		return errors.New("matrix room id must start with '!'")
+	}
+
+	if c.ThreadID != "" && !strings.HasPrefix(c.ThreadID, "$") {
+		return errors.New("matrix thread id must start with '$'")
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package matrix_test
+
+import (
+	"testing"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/matrix"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      *matrix.Config
+		expectError bool
+	}{
+		{
+			name: "valid config",
+			config: &matrix.Config{
+				Homeserver:  "https://matrix.org",
+				AccessToken: "token",
+				RoomID:      "!room:matrix.org",
+			},
+			expectError: false,
+		},
+		{
+			name: "valid config with thread",
+			config: &matrix.Config{
+				Homeserver:  "https://matrix.org",
+				AccessToken: "token",
+				RoomID:      "!room:matrix.org",
+				ThreadID:    "$thread",
+			},
+			expectError: false,
+		},
+		{
+			name: "missing homeserver",
+			config: &matrix.Config{
+				AccessToken: "token",
+				RoomID:      "!room:matrix.org",
+			},
+			expectError: true,
+		},
+		{
+			name: "missing access token",
+			config: &matrix.Config{
+				Homeserver: "https://matrix.org",
+				RoomID:     "!room:matrix.org",
+			},
+			expectError: true,
+		},
+		{
+			name: "room alias instead of id",
+			config: &matrix.Config{
+				Homeserver:  "https://matrix.org",
+				AccessToken: "token",
+				RoomID:      "#room:matrix.org",
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid thread id",
+			config: &matrix.Config{
+				Homeserver:  "https://matrix.org",
+				AccessToken: "token",
+				RoomID:      "!room:matrix.org",
+				ThreadID:    "thread",
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,244 @@
+package matrix
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/sirupsen/logrus"
+)
+
+const SourceType = "matrix"
+
+type Matrix struct {
+	log     logrus.FieldLogger
+	config  *Config
+	monitor string
+	name    string
+	client  *http.Client
+	metrics *Metrics
+
+	includeMonitorName bool
+	includeGroupName   bool
+	docs               *string
+
+	// alerts maps an alert key to the event id of the message that was sent when it fired
+	alerts map[string]string
+	mu     sync.Mutex
+	txnID  atomic.Uint64
+}
+
+// Message is the content of an m.room.message event.
+type Message struct {
+	MsgType       string     `json:"msgtype"`
+	Body          string     `json:"body"`
+	Format        string     `json:"format,omitempty"`
+	FormattedBody string     `json:"formatted_body,omitempty"`
+	RelatesTo     *RelatesTo `json:"m.relates_to,omitempty"`
+}
+
+// RelatesTo places a message in a thread and/or marks it as a reply.
+type RelatesTo struct {
+	RelType       string     `json:"rel_type,omitempty"`
+	EventID       string     `json:"event_id,omitempty"`
+	IsFallingBack bool       `json:"is_falling_back,omitempty"`
+	InReplyTo     *InReplyTo `json:"m.in_reply_to,omitempty"`
+}
+
+type InReplyTo struct {
+	EventID string `json:"event_id"`
+}
+
+type sendResponse struct {
+	EventID string `json:"event_id"`
+}
+
+func NewMatrix(ctx context.Context, log logrus.FieldLogger, monitor, name string, docs *string, includeMonitorName, includeGroupName bool, config *Config) (*Matrix, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Matrix{
+		log:                log.WithField("source", name),
+		config:             config,
+		monitor:            monitor,
+		name:               name,
+		client:             &http.Client{Timeout: 10 * time.Second},
+		metrics:            GetMetricsInstance("splitoor_notifier_matrix", monitor),
+		includeMonitorName: includeMonitorName,
+		includeGroupName:   includeGroupName,
+		docs:               docs,
+		alerts:             make(map[string]string),
+	}, nil
+}
+
+func (m *Matrix) Start(ctx context.Context) error {
+	return nil
+}
+
+func (m *Matrix) Stop(ctx context.Context) error {
+	return nil
+}
+
+func (m *Matrix) GetType() string {
+	return SourceType
+}
+
+func (m *Matrix) GetName() string {
+	return m.name
+}
+
+func (m *Matrix) GetConfig() *Config {
+	return m.config
+}
+
+func (m *Matrix) Publish(ctx context.Context, e event.Event) error {
+	var errorType string
+	defer func() {
+		if errorType != "" {
+			m.metrics.IncErrors(e.GetGroup(), m.name, m.GetType(), errorType)
+		}
+	}()
+
+	key := AlertKey(e)
+	resolved := event.IsResolved(e)
+
+	message := m.buildMessage(e)
+
+	if resolved && m.config.ReplyToAlert {
+		if alertEventID, ok := m.alertEventID(key); ok {
+			if message.RelatesTo == nil {
+				message.RelatesTo = &RelatesTo{}
+			}
+
+			message.RelatesTo.IsFallingBack = false
+			message.RelatesTo.InReplyTo = &InReplyTo{EventID: alertEventID}
+		}
+	}
+
+	jsonData, err := json.Marshal(message)
+	if err != nil {
+		errorType = "marshal_error"
+
+		return fmt.Errorf("failed to marshal matrix message: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("%s/_matrix/client/v3/rooms/%s/send/m.room.message/%s",
+		strings.TrimSuffix(m.config.Homeserver, "/"),
+		url.PathEscape(m.config.RoomID),
+		url.PathEscape(m.nextTxnID()),
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, endpoint, bytes.NewBuffer(jsonData))
+	if err != nil {
+		errorType = "request_error"
+
+		return fmt.Errorf("failed to create matrix request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+m.config.AccessToken)
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		errorType = "send_message"
+
+		return fmt.Errorf("failed to send matrix message: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		errorType = "status_error"
+
+		return fmt.Errorf("matrix returned non-200 status code: %d", resp.StatusCode)
+	}
+
+	var sent sendResponse
+	if err := json.NewDecoder(resp.Body).Decode(&sent); err != nil {
+		errorType = "decode_error"
+
+		return fmt.Errorf("failed to decode matrix response: %w", err)
+	}
+
+	m.trackAlert(key, sent.EventID, resolved)
+
+	m.metrics.IncMessagesPublished(e.GetGroup(), m.name, m.GetType())
+
+	return nil
+}
+
+func (m *Matrix) buildMessage(e event.Event) *Message {
+	emoji := event.EmojiPrefix(e)
+
+	title := e.GetTitle(m.includeMonitorName, m.includeGroupName)
+	body := fmt.Sprintf("%s**%s**\n\n%s", emoji, title, e.GetDescriptionMarkdown(m.includeMonitorName, m.includeGroupName))
+	html := fmt.Sprintf("<h4>%s%s</h4>%s", emoji, title, e.GetDescriptionHTML(m.includeMonitorName, m.includeGroupName))
+
+	if m.docs != nil {
+		docURL := strings.ReplaceAll(*m.docs, ":group", url.QueryEscape(e.GetGroup()))
+		body = fmt.Sprintf("%s\n\n[Go to docs](%s)", body, docURL)
+		html = fmt.Sprintf("%s<p><a href=\"%s\">Go to docs</a></p>", html, docURL)
+	}
+
+	message := &Message{
+		MsgType:       "m.text",
+		Body:          body,
+		Format:        "org.matrix.custom.html",
+		FormattedBody: html,
+	}
+
+	if m.config.ThreadID != "" {
+		message.RelatesTo = &RelatesTo{
+			RelType:       "m.thread",
+			EventID:       m.config.ThreadID,
+			IsFallingBack: true,
+			InReplyTo:     &InReplyTo{EventID: m.config.ThreadID},
+		}
+	}
+
+	return message
+}
+
+func (m *Matrix) alertEventID(key string) (string, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	eventID, ok := m.alerts[key]
+
+	return eventID, ok
+}
+
+// trackAlert remembers the message of a firing alert so its resolution can reply to it.
+func (m *Matrix) trackAlert(key, eventID string, resolved bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if resolved {
+		delete(m.alerts, key)
+
+		return
+	}
+
+	if eventID != "" {
+		m.alerts[key] = eventID
+	}
+}
+
+// nextTxnID returns a transaction id that is unique for this access token.
+func (m *Matrix) nextTxnID() string {
+	return fmt.Sprintf("splitoor-%d-%d", time.Now().UnixNano(), m.txnID.Add(1))
+}
+
+// AlertKey identifies an alert per monitor, group, event type and subject.
+func AlertKey(e event.Event) string {
+	return strings.Join([]string{e.GetMonitor(), e.GetGroup(), event.GetAlertType(e), event.GetSubject(e)}, "/")
+}
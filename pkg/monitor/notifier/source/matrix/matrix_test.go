@@ -0,0 +1,139 @@
+package matrix_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/split"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/matrix"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type roomServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	messages []*matrix.Message
+	paths    []string
+	status   int
+}
+
+func newRoomServer(t *testing.T) *roomServer {
+	t.Helper()
+
+	s := &roomServer{status: http.StatusOK}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+
+		var message matrix.Message
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&message))
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.messages = append(s.messages, &message)
+		s.paths = append(s.paths, r.URL.Path)
+
+		w.WriteHeader(s.status)
+
+		if s.status == http.StatusOK {
+			fmt.Fprintf(w, `{"event_id":"$event%d"}`, len(s.messages))
+		}
+	}))
+
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func TestMatrixPublish(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	docs := "https://docs.example.com/:group"
+
+	server := newRoomServer(t)
+
+	m, err := matrix.NewMatrix(context.Background(), logrus.New(), "monitor", "test_source", &docs, true, true, &matrix.Config{
+		Homeserver:   server.URL,
+		AccessToken:  "token",
+		RoomID:       "!room:example.com",
+		ReplyToAlert: true,
+	})
+	require.NoError(t, err)
+
+	trigger := split.NewHashUnknownState(now, "monitor", "group", "0x123", "expected", "actual")
+	resolve := event.NewResolved(now, now.Add(-time.Hour), "monitor", "group", split.HashUnknownStateType, split.HashUnknownStateSeverity, "Split hash is in unknown state", "0x123")
+
+	require.NoError(t, m.Publish(context.Background(), trigger))
+	require.NoError(t, m.Publish(context.Background(), resolve))
+
+	require.Len(t, server.messages, 2)
+
+	for _, path := range server.paths {
+		assert.True(t, strings.HasPrefix(path, "/_matrix/client/v3/rooms/!room:example.com/send/m.room.message/"))
+	}
+
+	assert.NotEqual(t, server.paths[0], server.paths[1], "transaction ids must be unique")
+
+	alert := server.messages[0]
+	assert.Equal(t, "m.text", alert.MsgType)
+	assert.Equal(t, "org.matrix.custom.html", alert.Format)
+	assert.Contains(t, alert.Body, "🚨 **"+trigger.GetTitle(true, true)+"**")
+	assert.Contains(t, alert.FormattedBody, trigger.GetDescriptionHTML(true, true))
+	assert.Contains(t, alert.FormattedBody, `<a href="https://docs.example.com/group">`)
+	assert.Nil(t, alert.RelatesTo)
+
+	resolved := server.messages[1]
+	assert.Contains(t, resolved.Body, "✅")
+	require.NotNil(t, resolved.RelatesTo)
+	require.NotNil(t, resolved.RelatesTo.InReplyTo)
+	assert.Equal(t, "$event1", resolved.RelatesTo.InReplyTo.EventID)
+}
+
+func TestMatrixPublishThread(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	server := newRoomServer(t)
+
+	m, err := matrix.NewMatrix(context.Background(), logrus.New(), "monitor", "test_source", nil, false, true, &matrix.Config{
+		Homeserver:  server.URL,
+		AccessToken: "token",
+		RoomID:      "!room:example.com",
+		ThreadID:    "$root",
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, m.Publish(context.Background(), split.NewHashInitialState(now, "monitor", "group", "0x123", "hash")))
+
+	require.Len(t, server.messages, 1)
+	require.NotNil(t, server.messages[0].RelatesTo)
+	assert.Equal(t, "m.thread", server.messages[0].RelatesTo.RelType)
+	assert.Equal(t, "$root", server.messages[0].RelatesTo.EventID)
+	assert.NotContains(t, server.messages[0].Body, "Go to docs")
+}
+
+func TestMatrixPublishError(t *testing.T) {
+	server := newRoomServer(t)
+	server.status = http.StatusForbidden
+
+	m, err := matrix.NewMatrix(context.Background(), logrus.New(), "monitor", "test_source", nil, true, true, &matrix.Config{
+		Homeserver:  server.URL,
+		AccessToken: "token",
+		RoomID:      "!room:example.com",
+	})
+	require.NoError(t, err)
+
+	err = m.Publish(context.Background(), split.NewHashInitialState(time.Now(), "monitor", "group", "0x123", "hash"))
+	assert.Error(t, err)
+}
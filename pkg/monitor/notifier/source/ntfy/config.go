@@ -0,0 +1,50 @@
+package ntfy
+
+import (
+	"errors"
+	"fmt"
+)
+
+type Config struct {
+	// Server is the url of the ntfy server.
+	Server string `yaml:"server" default:"https://ntfy.sh"`
+	Topic  string `yaml:"topic"`
+	// Token is an access token, alternatively use username and password.
+	Token    string `yaml:"token"`
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+	// Priorities overrides the priority (1-5) for an event type, eg. split_hash_initial_state: 2
+	Priorities map[string]int `yaml:"priorities"`
+	// ResolvedPriority is the priority of resolved notifications.
+	ResolvedPriority *int `yaml:"resolvedPriority" default:"2"`
+}
+
+func (c *Config) Validate() error {
+	if c.Server == "" {
+		return errors.New("server is required")
+	}
+
+	if c.Topic == "" {
+		return errors.New("topic is required")
+	}
+
+	if c.Token != "" && c.Username != "" {
+		return errors.New("only one of token or username/password can be set")
+	}
+
+	if c.Username != "" && c.Password == "" {
+		return errors.New("password is required when username is set")
+	}
+
+	for eventType, priority := range c.Priorities {
+		if !isValidPriority(priority) {
+			return fmt.Errorf("invalid priority %d for event type %s, must be between %d and %d", priority, eventType, PriorityMin, PriorityMax)
+		}
+	}
+
+	if c.ResolvedPriority != nil && !isValidPriority(*c.ResolvedPriority) {
+		return fmt.Errorf("invalid resolved priority %d, must be between %d and %d", *c.ResolvedPriority, PriorityMin, PriorityMax)
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package ntfy_test
+
+import (
+	"testing"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/ntfy"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigValidate(t *testing.T) {
+	invalidPriority := 0
+
+	tests := []struct {
+		name        string
+		config      *ntfy.Config
+		expectError bool
+	}{
+		{
+			name: "valid config",
+			config: &ntfy.Config{
+				Server:     "https://ntfy.sh",
+				Topic:      "topic",
+				Token:      "tk_token",
+				Priorities: map[string]int{"split_hash_initial_state": 2},
+			},
+			expectError: false,
+		},
+		{
+			name: "valid config with basic auth",
+			config: &ntfy.Config{
+				Server:   "https://ntfy.sh",
+				Topic:    "topic",
+				Username: "user",
+				Password: "pass",
+			},
+			expectError: false,
+		},
+		{
+			name: "missing topic",
+			config: &ntfy.Config{
+				Server: "https://ntfy.sh",
+			},
+			expectError: true,
+		},
+		{
+			name: "token and username",
+			config: &ntfy.Config{
+				Server:   "https://ntfy.sh",
+				Topic:    "topic",
+				Token:    "tk_token",
+				Username: "user",
+				Password: "pass",
+			},
+			expectError: true,
+		},
+		{
+			name: "missing password",
+			config: &ntfy.Config{
+				Server:   "https://ntfy.sh",
+				Topic:    "topic",
+				Username: "user",
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid priority",
+			config: &ntfy.Config{
+				Server:     "https://ntfy.sh",
+				Topic:      "topic",
+				Priorities: map[string]int{"split_controller": 6},
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid resolved priority",
+			config: &ntfy.Config{
+				Server:           "https://ntfy.sh",
+				Topic:            "topic",
+				ResolvedPriority: &invalidPriority,
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package ntfy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/sirupsen/logrus"
+)
+
+const SourceType = "ntfy"
+
+type Ntfy struct {
+	log     logrus.FieldLogger
+	name    string
+	config  *Config
+	client  *http.Client
+	metrics *Metrics
+
+	includeMonitorName bool
+	includeGroupName   bool
+	docs               *string
+}
+
+// Message is the body of a JSON publish request.
+type Message struct {
+	Topic    string   `json:"topic"`
+	Title    string   `json:"title"`
+	Message  string   `json:"message"`
+	Priority int      `json:"priority"`
+	Tags     []string `json:"tags,omitempty"`
+	Click    string   `json:"click,omitempty"`
+}
+
+func NewNtfy(ctx context.Context, log logrus.FieldLogger, monitor, name string, docs *string, includeMonitorName, includeGroupName bool, config *Config) (*Ntfy, error) {
+	return &Ntfy{
+		log:                log.WithField("source", name),
+		name:               name,
+		config:             config,
+		client:             &http.Client{},
+		metrics:            GetMetricsInstance("splitoor_notifier_ntfy", monitor),
+		includeMonitorName: includeMonitorName,
+		includeGroupName:   includeGroupName,
+		docs:               docs,
+	}, nil
+}
+
+func (c *Ntfy) Start(ctx context.Context) error {
+	return nil
+}
+
+func (c *Ntfy) Stop(ctx context.Context) error {
+	return nil
+}
+
+func (c *Ntfy) GetType() string {
+	return SourceType
+}
+
+func (c *Ntfy) GetName() string {
+	return c.name
+}
+
+func (c *Ntfy) GetConfig() *Config {
+	return c.config
+}
+
+func (c *Ntfy) Publish(ctx context.Context, e event.Event) error {
+	log := c.log.WithField("group", e.GetGroup())
+	log.Debug("Publishing message to ntfy")
+
+	var errorType string
+
+	var statusCode string
+
+	defer func() {
+		if errorType != "" {
+			c.metrics.IncErrors(e.GetGroup(), c.name, c.GetType(), errorType, statusCode)
+		} else {
+			c.metrics.IncMessagesPublished(e.GetGroup(), c.name, c.GetType())
+		}
+	}()
+
+	// ntfy renders known tags as emojis in front of the title
+	tag := "rotating_light"
+	if event.IsResolved(e) {
+		tag = "white_check_mark"
+	}
+
+	message := &Message{
+		Topic:    c.config.Topic,
+		Title:    e.GetTitle(c.includeMonitorName, c.includeGroupName),
+		Message:  e.GetDescriptionText(c.includeMonitorName, c.includeGroupName),
+		Priority: GetPriority(e, c.config.Priorities, c.config.ResolvedPriority),
+		Tags:     []string{tag},
+	}
+
+	if c.docs != nil {
+		message.Click = strings.ReplaceAll(*c.docs, ":group", url.QueryEscape(e.GetGroup()))
+	}
+
+	jsonData, err := json.Marshal(message)
+	if err != nil {
+		errorType = "marshal_error"
+
+		return fmt.Errorf("failed to marshal ntfy message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.config.Server, bytes.NewBuffer(jsonData))
+	if err != nil {
+		errorType = "request_error"
+
+		return fmt.Errorf("failed to create ntfy request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	if c.config.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.config.Token)
+	} else if c.config.Username != "" {
+		req.SetBasicAuth(c.config.Username, c.config.Password)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		errorType = "send_error"
+
+		return fmt.Errorf("failed to send ntfy message: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		statusCode = strconv.Itoa(resp.StatusCode)
+		errorType = "status_error"
+
+		log.WithField("status_code", resp.StatusCode).Error("ntfy returned non-200 status code")
+
+		return fmt.Errorf("ntfy returned non-200 status code: %d", resp.StatusCode)
+	}
+
+	log.Debug("Successfully published message to ntfy")
+
+	return nil
+}
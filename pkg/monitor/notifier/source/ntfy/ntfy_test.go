@@ -0,0 +1,135 @@
+package ntfy_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/split"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/ntfy"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPriority(t *testing.T) {
+	now := time.Now()
+	resolvedPriority := ntfy.PriorityMin
+
+	tests := []struct {
+		name      string
+		event     event.Event
+		overrides map[string]int
+		resolved  *int
+		want      int
+	}{
+		{
+			name:  "default priority",
+			event: split.NewController(now, "monitor", "group", "0x123", "0x456", "0x789"),
+			want:  ntfy.PriorityMax,
+		},
+		{
+			name:  "info event",
+			event: split.NewHashInitialState(now, "monitor", "group", "0x123", "hash"),
+			want:  ntfy.PriorityDefault,
+		},
+		{
+			name:      "override",
+			event:     split.NewHashInitialState(now, "monitor", "group", "0x123", "hash"),
+			overrides: map[string]int{split.HashInitialStateType: ntfy.PriorityLow},
+			want:      ntfy.PriorityLow,
+		},
+		{
+			name:     "resolved",
+			event:    event.NewResolved(now, now, "monitor", "group", split.ControllerType, split.ControllerSeverity, "title", "0x123"),
+			resolved: &resolvedPriority,
+			want:     ntfy.PriorityMin,
+		},
+		{
+			name:  "resolved without resolved priority",
+			event: event.NewResolved(now, now, "monitor", "group", split.ControllerType, split.ControllerSeverity, "title", "0x123"),
+			want:  ntfy.PriorityMax,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ntfy.GetPriority(tt.event, tt.overrides, tt.resolved))
+		})
+	}
+}
+
+func TestNtfyPublish(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	docs := "https://docs.example.com/:group"
+	e := split.NewHashUnknownState(now, "monitor", "group", "0x123", "expected", "actual")
+
+	tests := []struct {
+		name           string
+		config         *ntfy.Config
+		serverResponse int
+		expectError    bool
+	}{
+		{
+			name:           "token auth",
+			config:         &ntfy.Config{Topic: "topic", Token: "tk_token"},
+			serverResponse: http.StatusOK,
+		},
+		{
+			name:           "basic auth",
+			config:         &ntfy.Config{Topic: "topic", Username: "user", Password: "pass"},
+			serverResponse: http.StatusOK,
+		},
+		{
+			name:           "forbidden",
+			config:         &ntfy.Config{Topic: "topic", Token: "tk_token"},
+			serverResponse: http.StatusForbidden,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.config.Token != "" {
+					assert.Equal(t, "Bearer tk_token", r.Header.Get("Authorization"))
+				} else {
+					username, password, ok := r.BasicAuth()
+					assert.True(t, ok)
+					assert.Equal(t, "user", username)
+					assert.Equal(t, "pass", password)
+				}
+
+				var message ntfy.Message
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&message))
+
+				assert.Equal(t, "topic", message.Topic)
+				assert.Equal(t, e.GetTitle(true, true), message.Title)
+				assert.Equal(t, e.GetDescriptionText(true, true), message.Message)
+				assert.Equal(t, ntfy.PriorityMax, message.Priority)
+				assert.Equal(t, []string{"rotating_light"}, message.Tags)
+				assert.Equal(t, "https://docs.example.com/group", message.Click)
+
+				w.WriteHeader(tt.serverResponse)
+			}))
+			defer server.Close()
+
+			tt.config.Server = server.URL
+
+			source, err := ntfy.NewNtfy(context.Background(), logrus.New(), "monitor", "test_source", &docs, true, true, tt.config)
+			require.NoError(t, err)
+
+			err = source.Publish(context.Background(), e)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
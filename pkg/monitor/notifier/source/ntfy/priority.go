@@ -0,0 +1,36 @@
+package ntfy
+
+import "github.com/ethpandaops/splitoor/pkg/monitor/event"
+
+const (
+	PriorityMin     = 1
+	PriorityLow     = 2
+	PriorityDefault = 3
+	PriorityHigh    = 4
+	PriorityMax     = 5
+)
+
+func isValidPriority(priority int) bool {
+	return priority >= PriorityMin && priority <= PriorityMax
+}
+
+// GetPriority returns the ntfy priority of an event, derived from the severity of the
+// event unless its type is overridden in config.
+func GetPriority(e event.Event, overrides map[string]int, resolved *int) int {
+	if event.IsResolved(e) && resolved != nil {
+		return *resolved
+	}
+
+	if priority, ok := overrides[event.GetAlertType(e)]; ok {
+		return priority
+	}
+
+	switch e.GetSeverity() {
+	case event.SeverityCritical:
+		return PriorityMax
+	case event.SeverityInfo:
+		return PriorityDefault
+	}
+
+	return PriorityHigh
+}
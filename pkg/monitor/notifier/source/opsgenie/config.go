@@ -0,0 +1,35 @@
+package opsgenie
+
+import (
+	"errors"
+	"fmt"
+)
+
+type Config struct {
+	// APIKey is the key of an Opsgenie API integration.
+	APIKey string `yaml:"apiKey"`
+	// URL is the Opsgenie API url, use https://api.eu.opsgenie.com for the EU instance.
+	URL string `yaml:"url" default:"https://api.opsgenie.com"`
+	// Priorities overrides the priority for an event type, eg. split_hash_initial_state: P5
+	Priorities map[string]string `yaml:"priorities"`
+	// Tags are added to every alert.
+	Tags []string `yaml:"tags"`
+}
+
+func (c *Config) Validate() error {
+	if c.APIKey == "" {
+		return errors.New("apiKey is required")
+	}
+
+	if c.URL == "" {
+		return errors.New("url is required")
+	}
+
+	for eventType, priority := range c.Priorities {
+		if !isValidPriority(priority) {
+			return fmt.Errorf("invalid priority %q for event type %s", priority, eventType)
+		}
+	}
+
+	return nil
+}
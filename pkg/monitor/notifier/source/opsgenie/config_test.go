@@ -0,0 +1,60 @@
+package opsgenie_test
+
+import (
+	"testing"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/opsgenie"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      *opsgenie.Config
+		expectError bool
+	}{
+		{
+			name: "valid config",
+			config: &opsgenie.Config{
+				APIKey:     "key",
+				URL:        "https://api.opsgenie.com",
+				Priorities: map[string]string{"split_hash_initial_state": "P5"},
+			},
+			expectError: false,
+		},
+		{
+			name: "missing api key",
+			config: &opsgenie.Config{
+				URL: "https://api.opsgenie.com",
+			},
+			expectError: true,
+		},
+		{
+			name: "missing url",
+			config: &opsgenie.Config{
+				APIKey: "key",
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid priority",
+			config: &opsgenie.Config{
+				APIKey:     "key",
+				URL:        "https://api.opsgenie.com",
+				Priorities: map[string]string{"split_controller": "P0"},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package opsgenie
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	SourceType = "opsgenie"
+
+	// limits of the Opsgenie alert api
+	maxMessageLength     = 130
+	maxDescriptionLength = 15000
+)
+
+type Opsgenie struct {
+	log     logrus.FieldLogger
+	name    string
+	config  *Config
+	client  *http.Client
+	metrics *Metrics
+
+	includeMonitorName bool
+	includeGroupName   bool
+	docs               *string
+}
+
+// CreateAlert is the request body to create an alert.
+type CreateAlert struct {
+	Message     string            `json:"message"`
+	Alias       string            `json:"alias"`
+	Description string            `json:"description"`
+	Tags        []string          `json:"tags"`
+	Details     map[string]string `json:"details"`
+	Entity      string            `json:"entity,omitempty"`
+	Source      string            `json:"source"`
+	Priority    string            `json:"priority"`
+}
+
+// CloseAlert is the request body to close an alert.
+type CloseAlert struct {
+	Source string `json:"source"`
+	Note   string `json:"note"`
+}
+
+func NewOpsgenie(ctx context.Context, log logrus.FieldLogger, monitor, name string, docs *string, includeMonitorName, includeGroupName bool, config *Config) (*Opsgenie, error) {
+	return &Opsgenie{
+		log:                log.WithField("source", name),
+		name:               name,
+		config:             config,
+		client:             &http.Client{},
+		metrics:            GetMetricsInstance("splitoor_notifier_opsgenie", monitor),
+		includeMonitorName: includeMonitorName,
+		includeGroupName:   includeGroupName,
+		docs:               docs,
+	}, nil
+}
+
+func (c *Opsgenie) Start(ctx context.Context) error {
+	return nil
+}
+
+func (c *Opsgenie) Stop(ctx context.Context) error {
+	return nil
+}
+
+func (c *Opsgenie) GetType() string {
+	return SourceType
+}
+
+func (c *Opsgenie) GetName() string {
+	return c.name
+}
+
+func (c *Opsgenie) GetConfig() *Config {
+	return c.config
+}
+
+func (c *Opsgenie) Publish(ctx context.Context, e event.Event) error {
+	log := c.log.WithField("group", e.GetGroup())
+	log.Debug("Publishing alert to Opsgenie")
+
+	var errorType string
+
+	var statusCode string
+
+	defer func() {
+		if errorType != "" {
+			c.metrics.IncErrors(e.GetGroup(), c.name, c.GetType(), errorType, statusCode)
+		} else {
+			c.metrics.IncMessagesPublished(e.GetGroup(), c.name, c.GetType())
+		}
+	}()
+
+	base := strings.TrimSuffix(c.config.URL, "/") + "/v2/alerts"
+
+	var endpoint string
+
+	var body interface{}
+
+	if event.IsResolved(e) {
+		endpoint = fmt.Sprintf("%s/%s/close?identifierType=alias", base, url.PathEscape(Alias(e)))
+		body = &CloseAlert{
+			Source: "splitoor",
+			Note:   e.GetDescriptionText(c.includeMonitorName, c.includeGroupName),
+		}
+	} else {
+		endpoint = base
+		body = c.createAlert(e)
+	}
+
+	jsonData, err := json.Marshal(body)
+	if err != nil {
+		errorType = "marshal_error"
+
+		return fmt.Errorf("failed to marshal opsgenie request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewBuffer(jsonData))
+	if err != nil {
+		errorType = "request_error"
+
+		return fmt.Errorf("failed to create opsgenie request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "GenieKey "+c.config.APIKey)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		errorType = "send_error"
+
+		return fmt.Errorf("failed to send opsgenie request: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		statusCode = strconv.Itoa(resp.StatusCode)
+		errorType = "status_error"
+
+		log.WithField("status_code", resp.StatusCode).Error("Opsgenie returned non-2xx status code")
+
+		return fmt.Errorf("opsgenie returned non-2xx status code: %d", resp.StatusCode)
+	}
+
+	log.Debug("Successfully published alert to Opsgenie")
+
+	return nil
+}
+
+func (c *Opsgenie) createAlert(e event.Event) *CreateAlert {
+	description := e.GetDescriptionText(c.includeMonitorName, c.includeGroupName)
+
+	details := map[string]string{
+		"monitor": e.GetMonitor(),
+		"group":   e.GetGroup(),
+		"type":    e.GetType(),
+	}
+
+	if c.docs != nil {
+		docURL := strings.ReplaceAll(*c.docs, ":group", url.QueryEscape(e.GetGroup()))
+
+		details["docs"] = docURL
+		description = fmt.Sprintf("%s\n\nDocs: %s", description, docURL)
+	}
+
+	tags := append([]string{"splitoor", "monitor:" + e.GetMonitor(), "group:" + e.GetGroup()}, c.config.Tags...)
+
+	return &CreateAlert{
+		Message:     truncate(e.GetTitle(c.includeMonitorName, c.includeGroupName), maxMessageLength),
+		Alias:       Alias(e),
+		Description: truncate(description, maxDescriptionLength),
+		Tags:        tags,
+		Details:     details,
+		Entity:      event.GetSubject(e),
+		Source:      "splitoor",
+		Priority:    GetPriority(e, c.config.Priorities),
+	}
+}
+
+// Alias deduplicates alerts per monitor, group, event type and subject.
+func Alias(e event.Event) string {
+	return strings.Join([]string{e.GetMonitor(), e.GetGroup(), event.GetAlertType(e), event.GetSubject(e)}, "/")
+}
+
+func truncate(s string, length int) string {
+	if runes := []rune(s); len(runes) > length {
+		return string(runes[:length])
+	}
+
+	return s
+}
@@ -0,0 +1,151 @@
+package opsgenie_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/split"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/validator"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/opsgenie"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPriority(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		event     event.Event
+		overrides map[string]string
+		want      string
+	}{
+		{
+			name:  "default priority",
+			event: split.NewController(now, "monitor", "group", "0x123", "0x456", "0x789"),
+			want:  opsgenie.PriorityP1,
+		},
+		{
+			name:      "override",
+			event:     split.NewHashInitialState(now, "monitor", "group", "0x123", "hash"),
+			overrides: map[string]string{split.HashInitialStateType: opsgenie.PriorityP5},
+			want:      opsgenie.PriorityP5,
+		},
+		{
+			name:  "slashed validator",
+			event: validator.NewStatus(now, "exited_slashed", "0xabc", "group", "monitor"),
+			want:  opsgenie.PriorityP1,
+		},
+		{
+			name:  "slashed validator reminder",
+			event: event.NewReminder(now, now, validator.NewStatus(now, "active_slashed", "0xabc", "group", "monitor")),
+			want:  opsgenie.PriorityP1,
+		},
+		{
+			name:  "exited validator",
+			event: validator.NewStatus(now, "exited_unslashed", "0xabc", "group", "monitor"),
+			want:  opsgenie.PriorityP3,
+		},
+		{
+			name:  "info event",
+			event: split.NewHashInitialState(now, "monitor", "group", "0x123", "hash"),
+			want:  opsgenie.PriorityP5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, opsgenie.GetPriority(tt.event, tt.overrides))
+		})
+	}
+}
+
+func TestOpsgeniePublish(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	docs := "https://docs.example.com/:group"
+
+	trigger := split.NewHashUnknownState(now, "monitor", "group", "0x123", "expected", "actual")
+	resolve := event.NewResolved(now, now.Add(-time.Hour), "monitor", "group", split.HashUnknownStateType, split.HashUnknownStateSeverity, "Split hash is in unknown state", "0x123")
+
+	tests := []struct {
+		name           string
+		event          event.Event
+		serverResponse int
+		wantPath       string
+		expectError    bool
+	}{
+		{
+			name:           "create",
+			event:          trigger,
+			serverResponse: http.StatusAccepted,
+			wantPath:       "/v2/alerts",
+		},
+		{
+			name:           "close",
+			event:          resolve,
+			serverResponse: http.StatusAccepted,
+			wantPath:       "/v2/alerts/monitor/group/split_hash_unknown_state/0x123/close",
+		},
+		{
+			name:           "unauthorized",
+			event:          trigger,
+			serverResponse: http.StatusUnauthorized,
+			wantPath:       "/v2/alerts",
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "GenieKey key", r.Header.Get("Authorization"))
+				assert.Equal(t, tt.wantPath, r.URL.Path)
+
+				if event.IsResolved(tt.event) {
+					assert.Equal(t, "alias", r.URL.Query().Get("identifierType"))
+
+					var closeAlert opsgenie.CloseAlert
+					assert.NoError(t, json.NewDecoder(r.Body).Decode(&closeAlert))
+					assert.Equal(t, "splitoor", closeAlert.Source)
+				} else {
+					var alert opsgenie.CreateAlert
+					assert.NoError(t, json.NewDecoder(r.Body).Decode(&alert))
+
+					assert.Equal(t, "monitor/group/split_hash_unknown_state/0x123", alert.Alias)
+					assert.Equal(t, opsgenie.PriorityP1, alert.Priority)
+					assert.Equal(t, "0x123", alert.Entity)
+					assert.Equal(t, trigger.GetTitle(true, true), alert.Message)
+					assert.Contains(t, alert.Tags, "group:group")
+					assert.Contains(t, alert.Tags, "monitor:monitor")
+					assert.Contains(t, alert.Tags, "custom")
+					assert.Equal(t, "https://docs.example.com/group", alert.Details["docs"])
+				}
+
+				w.WriteHeader(tt.serverResponse)
+			}))
+			defer server.Close()
+
+			og, err := opsgenie.NewOpsgenie(context.Background(), logrus.New(), "monitor", "test_source", &docs, true, true, &opsgenie.Config{
+				APIKey: "key",
+				URL:    server.URL,
+				Tags:   []string{"custom"},
+			})
+			require.NoError(t, err)
+
+			err = og.Publish(context.Background(), tt.event)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
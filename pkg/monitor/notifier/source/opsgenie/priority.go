@@ -0,0 +1,37 @@
+package opsgenie
+
+import "github.com/ethpandaops/splitoor/pkg/monitor/event"
+
+const (
+	PriorityP1 = "P1"
+	PriorityP2 = "P2"
+	PriorityP3 = "P3"
+	PriorityP4 = "P4"
+	PriorityP5 = "P5"
+)
+
+func isValidPriority(priority string) bool {
+	switch priority {
+	case PriorityP1, PriorityP2, PriorityP3, PriorityP4, PriorityP5:
+		return true
+	}
+
+	return false
+}
+
+// GetPriority returns the Opsgenie priority of an event, derived from the severity of the
+// event unless its type is overridden in config.
+func GetPriority(e event.Event, overrides map[string]string) string {
+	if priority, ok := overrides[event.GetAlertType(e)]; ok {
+		return priority
+	}
+
+	switch e.GetSeverity() {
+	case event.SeverityCritical:
+		return PriorityP1
+	case event.SeverityInfo:
+		return PriorityP5
+	}
+
+	return PriorityP3
+}
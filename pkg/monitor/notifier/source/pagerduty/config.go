@@ -0,0 +1,33 @@
+package pagerduty
+
+import (
+	"errors"
+	"fmt"
+)
+
+type Config struct {
+	// RoutingKey is the integration key of the Events API v2 service.
+	RoutingKey string `yaml:"routingKey"`
+	// URL is the Events API v2 enqueue endpoint.
+	URL string `yaml:"url" default:"https://events.pagerduty.com/v2/enqueue"`
+	// Severities overrides the severity for an event type, eg. split_hash_initial_state: info
+	Severities map[string]string `yaml:"severities"`
+}
+
+func (c *Config) Validate() error {
+	if c.RoutingKey == "" {
+		return errors.New("routingKey is required")
+	}
+
+	if c.URL == "" {
+		return errors.New("url is required")
+	}
+
+	for eventType, severity := range c.Severities {
+		if !isValidSeverity(severity) {
+			return fmt.Errorf("invalid severity %q for event type %s", severity, eventType)
+		}
+	}
+
+	return nil
+}
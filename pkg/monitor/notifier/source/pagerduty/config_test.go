@@ -0,0 +1,60 @@
+package pagerduty_test
+
+import (
+	"testing"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/pagerduty"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      *pagerduty.Config
+		expectError bool
+	}{
+		{
+			name: "valid config",
+			config: &pagerduty.Config{
+				RoutingKey: "key",
+				URL:        "https://events.pagerduty.com/v2/enqueue",
+				Severities: map[string]string{"split_hash_initial_state": "info"},
+			},
+			expectError: false,
+		},
+		{
+			name: "missing routing key",
+			config: &pagerduty.Config{
+				URL: "https://events.pagerduty.com/v2/enqueue",
+			},
+			expectError: true,
+		},
+		{
+			name: "missing url",
+			config: &pagerduty.Config{
+				RoutingKey: "key",
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid severity",
+			config: &pagerduty.Config{
+				RoutingKey: "key",
+				URL:        "https://events.pagerduty.com/v2/enqueue",
+				Severities: map[string]string{"split_controller": "urgent"},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
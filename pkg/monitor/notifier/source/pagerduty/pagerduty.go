@@ -0,0 +1,205 @@
+package pagerduty
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	SourceType = "pagerduty"
+
+	ActionTrigger = "trigger"
+	ActionResolve = "resolve"
+
+	// maxSummaryLength is the maximum length of the summary accepted by PagerDuty.
+	maxSummaryLength = 1024
+)
+
+type PagerDuty struct {
+	log     logrus.FieldLogger
+	name    string
+	config  *Config
+	client  *http.Client
+	metrics *Metrics
+
+	includeMonitorName bool
+	includeGroupName   bool
+	docs               *string
+}
+
+// Event is an Events API v2 request.
+type Event struct {
+	RoutingKey  string   `json:"routing_key"`
+	EventAction string   `json:"event_action"`
+	DedupKey    string   `json:"dedup_key"`
+	Payload     *Payload `json:"payload,omitempty"`
+	Links       []Link   `json:"links,omitempty"`
+}
+
+type Payload struct {
+	Summary       string            `json:"summary"`
+	Source        string            `json:"source"`
+	Severity      string            `json:"severity"`
+	Component     string            `json:"component,omitempty"`
+	Group         string            `json:"group,omitempty"`
+	Class         string            `json:"class,omitempty"`
+	CustomDetails map[string]string `json:"custom_details,omitempty"`
+}
+
+type Link struct {
+	Href string `json:"href"`
+	Text string `json:"text"`
+}
+
+func NewPagerDuty(ctx context.Context, log logrus.FieldLogger, monitor, name string, docs *string, includeMonitorName, includeGroupName bool, config *Config) (*PagerDuty, error) {
+	return &PagerDuty{
+		log:                log.WithField("source", name),
+		name:               name,
+		config:             config,
+		client:             &http.Client{},
+		metrics:            GetMetricsInstance("splitoor_notifier_pagerduty", monitor),
+		includeMonitorName: includeMonitorName,
+		includeGroupName:   includeGroupName,
+		docs:               docs,
+	}, nil
+}
+
+func (c *PagerDuty) Start(ctx context.Context) error {
+	return nil
+}
+
+func (c *PagerDuty) Stop(ctx context.Context) error {
+	return nil
+}
+
+func (c *PagerDuty) GetType() string {
+	return SourceType
+}
+
+func (c *PagerDuty) GetName() string {
+	return c.name
+}
+
+func (c *PagerDuty) GetConfig() *Config {
+	return c.config
+}
+
+func (c *PagerDuty) Publish(ctx context.Context, e event.Event) error {
+	log := c.log.WithField("group", e.GetGroup())
+	log.Debug("Publishing event to PagerDuty")
+
+	var errorType string
+
+	var statusCode string
+
+	defer func() {
+		if errorType != "" {
+			c.metrics.IncErrors(e.GetGroup(), c.name, c.GetType(), errorType, statusCode)
+		} else {
+			c.metrics.IncMessagesPublished(e.GetGroup(), c.name, c.GetType())
+		}
+	}()
+
+	jsonData, err := json.Marshal(c.event(e))
+	if err != nil {
+		errorType = "marshal_error"
+
+		return fmt.Errorf("failed to marshal pagerduty event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.config.URL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		errorType = "request_error"
+
+		return fmt.Errorf("failed to create pagerduty request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		errorType = "send_error"
+
+		return fmt.Errorf("failed to send pagerduty event: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		statusCode = strconv.Itoa(resp.StatusCode)
+		errorType = "status_error"
+
+		log.WithField("status_code", resp.StatusCode).Error("PagerDuty returned non-2xx status code")
+
+		return fmt.Errorf("pagerduty returned non-2xx status code: %d", resp.StatusCode)
+	}
+
+	log.Debug("Successfully published event to PagerDuty")
+
+	return nil
+}
+
+func (c *PagerDuty) event(e event.Event) *Event {
+	dedupKey := DedupKey(e)
+
+	// resolve events only need the dedup key of the alert they resolve
+	if event.IsResolved(e) {
+		return &Event{
+			RoutingKey:  c.config.RoutingKey,
+			EventAction: ActionResolve,
+			DedupKey:    dedupKey,
+		}
+	}
+
+	summary := e.GetTitle(c.includeMonitorName, c.includeGroupName)
+	if runes := []rune(summary); len(runes) > maxSummaryLength {
+		summary = string(runes[:maxSummaryLength])
+	}
+
+	source := event.GetSubject(e)
+	if source == "" {
+		source = e.GetMonitor()
+	}
+
+	pdEvent := &Event{
+		RoutingKey:  c.config.RoutingKey,
+		EventAction: ActionTrigger,
+		DedupKey:    dedupKey,
+		Payload: &Payload{
+			Summary:   summary,
+			Source:    source,
+			Severity:  GetSeverity(e, c.config.Severities),
+			Component: e.GetMonitor(),
+			Group:     e.GetGroup(),
+			Class:     e.GetType(),
+			CustomDetails: map[string]string{
+				"description": e.GetDescriptionText(c.includeMonitorName, c.includeGroupName),
+			},
+		},
+	}
+
+	if c.docs != nil {
+		pdEvent.Links = []Link{
+			{
+				Href: strings.ReplaceAll(*c.docs, ":group", url.QueryEscape(e.GetGroup())),
+				Text: "Go to docs",
+			},
+		}
+	}
+
+	return pdEvent
+}
+
+// DedupKey identifies an alert across its trigger and resolve events.
+func DedupKey(e event.Event) string {
+	return strings.Join([]string{e.GetMonitor(), e.GetGroup(), event.GetAlertType(e), event.GetSubject(e)}, "/")
+}
@@ -0,0 +1,144 @@
+package pagerduty_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/split"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/validator"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/pagerduty"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSeverity(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		event     event.Event
+		overrides map[string]string
+		want      string
+	}{
+		{
+			name:  "default severity",
+			event: split.NewController(now, "monitor", "group", "0x123", "0x456", "0x789"),
+			want:  pagerduty.SeverityCritical,
+		},
+		{
+			name:      "override",
+			event:     split.NewHashInitialState(now, "monitor", "group", "0x123", "hash"),
+			overrides: map[string]string{split.HashInitialStateType: pagerduty.SeverityInfo},
+			want:      pagerduty.SeverityInfo,
+		},
+		{
+			name:  "slashed validator",
+			event: validator.NewStatus(now, "exited_slashed", "0xabc", "group", "monitor"),
+			want:  pagerduty.SeverityCritical,
+		},
+		{
+			name:  "slashed validator reminder",
+			event: event.NewReminder(now, now, validator.NewStatus(now, "active_slashed", "0xabc", "group", "monitor")),
+			want:  pagerduty.SeverityCritical,
+		},
+		{
+			name:  "exited validator",
+			event: validator.NewStatus(now, "exited_unslashed", "0xabc", "group", "monitor"),
+			want:  pagerduty.SeverityWarning,
+		},
+		{
+			name:  "info event",
+			event: split.NewHashInitialState(now, "monitor", "group", "0x123", "hash"),
+			want:  pagerduty.SeverityInfo,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, pagerduty.GetSeverity(tt.event, tt.overrides))
+		})
+	}
+}
+
+func TestPagerDutyPublish(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	docs := "https://docs.example.com/:group"
+
+	trigger := split.NewHashUnknownState(now, "monitor", "group", "0x123", "expected", "actual")
+	resolve := event.NewResolved(now, now.Add(-time.Hour), "monitor", "group", split.HashUnknownStateType, split.HashUnknownStateSeverity, "Split hash is in unknown state", "0x123")
+
+	tests := []struct {
+		name           string
+		event          event.Event
+		serverResponse int
+		wantAction     string
+		expectError    bool
+	}{
+		{
+			name:           "trigger",
+			event:          trigger,
+			serverResponse: http.StatusAccepted,
+			wantAction:     pagerduty.ActionTrigger,
+		},
+		{
+			name:           "resolve",
+			event:          resolve,
+			serverResponse: http.StatusAccepted,
+			wantAction:     pagerduty.ActionResolve,
+		},
+		{
+			name:           "rate limited",
+			event:          trigger,
+			serverResponse: http.StatusTooManyRequests,
+			wantAction:     pagerduty.ActionTrigger,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var pdEvent pagerduty.Event
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&pdEvent))
+
+				assert.Equal(t, "key", pdEvent.RoutingKey)
+				assert.Equal(t, tt.wantAction, pdEvent.EventAction)
+				assert.Equal(t, "monitor/group/split_hash_unknown_state/0x123", pdEvent.DedupKey)
+
+				if tt.wantAction == pagerduty.ActionTrigger {
+					require.NotNil(t, pdEvent.Payload)
+					assert.Equal(t, pagerduty.SeverityCritical, pdEvent.Payload.Severity)
+					assert.Equal(t, "0x123", pdEvent.Payload.Source)
+					assert.Equal(t, trigger.GetTitle(true, true), pdEvent.Payload.Summary)
+					require.Len(t, pdEvent.Links, 1)
+					assert.Equal(t, "https://docs.example.com/group", pdEvent.Links[0].Href)
+				} else {
+					assert.Nil(t, pdEvent.Payload)
+				}
+
+				w.WriteHeader(tt.serverResponse)
+			}))
+			defer server.Close()
+
+			pd, err := pagerduty.NewPagerDuty(context.Background(), logrus.New(), "monitor", "test_source", &docs, true, true, &pagerduty.Config{
+				RoutingKey: "key",
+				URL:        server.URL,
+			})
+			require.NoError(t, err)
+
+			err = pd.Publish(context.Background(), tt.event)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package pagerduty
+
+import "github.com/ethpandaops/splitoor/pkg/monitor/event"
+
+const (
+	SeverityCritical = "critical"
+	SeverityError    = "error"
+	SeverityWarning  = "warning"
+	SeverityInfo     = "info"
+)
+
+func isValidSeverity(severity string) bool {
+	switch severity {
+	case SeverityCritical, SeverityError, SeverityWarning, SeverityInfo:
+		return true
+	}
+
+	return false
+}
+
+// GetSeverity returns the PagerDuty severity of an event, the severity of the event unless
+// its type is overridden in config.
+func GetSeverity(e event.Event, overrides map[string]string) string {
+	if severity, ok := overrides[event.GetAlertType(e)]; ok {
+		return severity
+	}
+
+	switch e.GetSeverity() {
+	case event.SeverityCritical:
+		return SeverityCritical
+	case event.SeverityInfo:
+		return SeverityInfo
+	}
+
+	return SeverityWarning
+}
@@ -0,0 +1,61 @@
+package pushover
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+type Config struct {
+	// URL is the pushover messages api url.
+	URL string `yaml:"url" default:"https://api.pushover.net/1/messages.json"`
+	// Token is the api token of a pushover application.
+	Token string `yaml:"token"`
+	// User is the user or group key to send notifications to.
+	User string `yaml:"user"`
+	// Device optionally limits notifications to a single device of the user.
+	Device string `yaml:"device"`
+	// Priorities overrides the priority (-2 to 2) for an event type, eg. split_controller: 2
+	Priorities map[string]int `yaml:"priorities"`
+	// ResolvedPriority is the priority of resolved notifications.
+	ResolvedPriority *int `yaml:"resolvedPriority" default:"-1"`
+	// Retry is how often emergency notifications are repeated until acknowledged.
+	Retry time.Duration `yaml:"retry" default:"1m"`
+	// Expire is how long emergency notifications are repeated for.
+	Expire time.Duration `yaml:"expire" default:"1h"`
+}
+
+func (c *Config) Validate() error {
+	if c.URL == "" {
+		return errors.New("url is required")
+	}
+
+	if c.Token == "" {
+		return errors.New("token is required")
+	}
+
+	if c.User == "" {
+		return errors.New("user is required")
+	}
+
+	for eventType, priority := range c.Priorities {
+		if !isValidPriority(priority) {
+			return fmt.Errorf("invalid priority %d for event type %s, must be between %d and %d", priority, eventType, PriorityLowest, PriorityEmergency)
+		}
+	}
+
+	if c.ResolvedPriority != nil && !isValidPriority(*c.ResolvedPriority) {
+		return fmt.Errorf("invalid resolved priority %d, must be between %d and %d", *c.ResolvedPriority, PriorityLowest, PriorityEmergency)
+	}
+
+	// limits of the pushover api for emergency notifications
+	if c.Retry < 30*time.Second {
+		return errors.New("retry must be at least 30s")
+	}
+
+	if c.Expire > 3*time.Hour {
+		return errors.New("expire must be at most 3h")
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package pushover_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/pushover"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      *pushover.Config
+		expectError bool
+	}{
+		{
+			name: "valid config",
+			config: &pushover.Config{
+				URL:        "https://api.pushover.net/1/messages.json",
+				Token:      "token",
+				User:       "user",
+				Priorities: map[string]int{"split_controller": 2},
+				Retry:      time.Minute,
+				Expire:     time.Hour,
+			},
+			expectError: false,
+		},
+		{
+			name: "missing token",
+			config: &pushover.Config{
+				URL:    "https://api.pushover.net/1/messages.json",
+				User:   "user",
+				Retry:  time.Minute,
+				Expire: time.Hour,
+			},
+			expectError: true,
+		},
+		{
+			name: "missing user",
+			config: &pushover.Config{
+				URL:    "https://api.pushover.net/1/messages.json",
+				Token:  "token",
+				Retry:  time.Minute,
+				Expire: time.Hour,
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid priority",
+			config: &pushover.Config{
+				URL:        "https://api.pushover.net/1/messages.json",
+				Token:      "token",
+				User:       "user",
+				Priorities: map[string]int{"split_controller": 3},
+				Retry:      time.Minute,
+				Expire:     time.Hour,
+			},
+			expectError: true,
+		},
+		{
+			name: "retry too short",
+			config: &pushover.Config{
+				URL:    "https://api.pushover.net/1/messages.json",
+				Token:  "token",
+				User:   "user",
+				Retry:  10 * time.Second,
+				Expire: time.Hour,
+			},
+			expectError: true,
+		},
+		{
+			name: "expire too long",
+			config: &pushover.Config{
+				URL:    "https://api.pushover.net/1/messages.json",
+				Token:  "token",
+				User:   "user",
+				Retry:  time.Minute,
+				Expire: 4 * time.Hour,
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package pushover
+
+import "github.com/ethpandaops/splitoor/pkg/monitor/event"
+
+// pushover priorities, emergency notifications repeat until acknowledged.
+const (
+	PriorityLowest    = -2
+	PriorityLow       = -1
+	PriorityNormal    = 0
+	PriorityHigh      = 1
+	PriorityEmergency = 2
+)
+
+func isValidPriority(priority int) bool {
+	return priority >= PriorityLowest && priority <= PriorityEmergency
+}
+
+// GetPriority returns the pushover priority of an event, derived from the severity of the
+// event unless its type is overridden in config.
+func GetPriority(e event.Event, overrides map[string]int, resolved *int) int {
+	if event.IsResolved(e) && resolved != nil {
+		return *resolved
+	}
+
+	if priority, ok := overrides[event.GetAlertType(e)]; ok {
+		return priority
+	}
+
+	switch e.GetSeverity() {
+	case event.SeverityCritical:
+		return PriorityHigh
+	case event.SeverityInfo:
+		return PriorityLow
+	}
+
+	return PriorityNormal
+}
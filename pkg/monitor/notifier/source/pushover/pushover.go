@@ -0,0 +1,162 @@
+package pushover
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	SourceType = "pushover"
+
+	// limits of the pushover api
+	maxTitleLength   = 250
+	maxMessageLength = 1024
+)
+
+type Pushover struct {
+	log     logrus.FieldLogger
+	name    string
+	config  *Config
+	client  *http.Client
+	metrics *Metrics
+
+	includeMonitorName bool
+	includeGroupName   bool
+	docs               *string
+}
+
+type apiResponse struct {
+	Status int      `json:"status"`
+	Errors []string `json:"errors"`
+}
+
+func NewPushover(ctx context.Context, log logrus.FieldLogger, monitor, name string, docs *string, includeMonitorName, includeGroupName bool, config *Config) (*Pushover, error) {
+	return &Pushover{
+		log:                log.WithField("source", name),
+		name:               name,
+		config:             config,
+		client:             &http.Client{},
+		metrics:            GetMetricsInstance("splitoor_notifier_pushover", monitor),
+		includeMonitorName: includeMonitorName,
+		includeGroupName:   includeGroupName,
+		docs:               docs,
+	}, nil
+}
+
+func (c *Pushover) Start(ctx context.Context) error {
+	return nil
+}
+
+func (c *Pushover) Stop(ctx context.Context) error {
+	return nil
+}
+
+func (c *Pushover) GetType() string {
+	return SourceType
+}
+
+func (c *Pushover) GetName() string {
+	return c.name
+}
+
+func (c *Pushover) GetConfig() *Config {
+	return c.config
+}
+
+func (c *Pushover) Publish(ctx context.Context, e event.Event) error {
+	log := c.log.WithField("group", e.GetGroup())
+	log.Debug("Publishing message to Pushover")
+
+	var errorType string
+
+	var statusCode string
+
+	defer func() {
+		if errorType != "" {
+			c.metrics.IncErrors(e.GetGroup(), c.name, c.GetType(), errorType, statusCode)
+		} else {
+			c.metrics.IncMessagesPublished(e.GetGroup(), c.name, c.GetType())
+		}
+	}()
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.config.URL, strings.NewReader(c.form(e).Encode()))
+	if err != nil {
+		errorType = "request_error"
+
+		return fmt.Errorf("failed to create pushover request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		errorType = "send_error"
+
+		return fmt.Errorf("failed to send pushover message: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		statusCode = strconv.Itoa(resp.StatusCode)
+		errorType = "status_error"
+
+		var apiResp apiResponse
+		if err := json.NewDecoder(resp.Body).Decode(&apiResp); err == nil && len(apiResp.Errors) > 0 {
+			log = log.WithField("errors", apiResp.Errors)
+		}
+
+		log.WithField("status_code", resp.StatusCode).Error("Pushover returned non-200 status code")
+
+		return fmt.Errorf("pushover returned non-200 status code: %d", resp.StatusCode)
+	}
+
+	log.Debug("Successfully published message to Pushover")
+
+	return nil
+}
+
+func (c *Pushover) form(e event.Event) url.Values {
+	emoji := event.EmojiPrefix(e)
+
+	priority := GetPriority(e, c.config.Priorities, c.config.ResolvedPriority)
+
+	form := url.Values{}
+	form.Set("token", c.config.Token)
+	form.Set("user", c.config.User)
+	form.Set("title", truncate(fmt.Sprintf("%s%s", emoji, e.GetTitle(c.includeMonitorName, c.includeGroupName)), maxTitleLength))
+	form.Set("message", truncate(e.GetDescriptionText(c.includeMonitorName, c.includeGroupName), maxMessageLength))
+	form.Set("priority", strconv.Itoa(priority))
+
+	if c.config.Device != "" {
+		form.Set("device", c.config.Device)
+	}
+
+	if priority == PriorityEmergency {
+		form.Set("retry", strconv.Itoa(int(c.config.Retry.Seconds())))
+		form.Set("expire", strconv.Itoa(int(c.config.Expire.Seconds())))
+	}
+
+	if c.docs != nil {
+		form.Set("url", strings.ReplaceAll(*c.docs, ":group", url.QueryEscape(e.GetGroup())))
+		form.Set("url_title", "Go to docs")
+	}
+
+	return form
+}
+
+func truncate(s string, length int) string {
+	if runes := []rune(s); len(runes) > length {
+		return string(runes[:length])
+	}
+
+	return s
+}
@@ -0,0 +1,105 @@
+package pushover_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/split"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/pushover"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPushoverPublish(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	docs := "https://docs.example.com/:group"
+
+	tests := []struct {
+		name           string
+		event          event.Event
+		priorities     map[string]int
+		serverResponse int
+		wantPriority   string
+		wantRetry      string
+		expectError    bool
+	}{
+		{
+			name:           "alert",
+			event:          split.NewHashUnknownState(now, "monitor", "group", "0x123", "expected", "actual"),
+			serverResponse: http.StatusOK,
+			wantPriority:   "1",
+		},
+		{
+			name:           "emergency",
+			event:          split.NewHashUnknownState(now, "monitor", "group", "0x123", "expected", "actual"),
+			priorities:     map[string]int{split.HashUnknownStateType: pushover.PriorityEmergency},
+			serverResponse: http.StatusOK,
+			wantPriority:   "2",
+			wantRetry:      "60",
+		},
+		{
+			name:           "resolved",
+			event:          event.NewResolved(now, now.Add(-time.Hour), "monitor", "group", split.HashUnknownStateType, split.HashUnknownStateSeverity, "Split hash is in unknown state", "0x123"),
+			serverResponse: http.StatusOK,
+			wantPriority:   "-1",
+		},
+		{
+			name:           "invalid token",
+			event:          split.NewHashUnknownState(now, "monitor", "group", "0x123", "expected", "actual"),
+			serverResponse: http.StatusBadRequest,
+			wantPriority:   "1",
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.NoError(t, r.ParseForm())
+
+				assert.Equal(t, "token", r.PostForm.Get("token"))
+				assert.Equal(t, "user", r.PostForm.Get("user"))
+				assert.Contains(t, r.PostForm.Get("title"), tt.event.GetTitle(true, true))
+				assert.Equal(t, tt.event.GetDescriptionText(true, true), r.PostForm.Get("message"))
+				assert.Equal(t, tt.wantPriority, r.PostForm.Get("priority"))
+				assert.Equal(t, tt.wantRetry, r.PostForm.Get("retry"))
+				assert.Equal(t, "https://docs.example.com/group", r.PostForm.Get("url"))
+
+				w.WriteHeader(tt.serverResponse)
+
+				if tt.serverResponse != http.StatusOK {
+					_, _ = w.Write([]byte(`{"status":0,"errors":["application token is invalid"]}`))
+				} else {
+					_, _ = w.Write([]byte(`{"status":1}`))
+				}
+			}))
+			defer server.Close()
+
+			resolvedPriority := pushover.PriorityLow
+
+			source, err := pushover.NewPushover(context.Background(), logrus.New(), "monitor", "test_source", &docs, true, true, &pushover.Config{
+				URL:              server.URL,
+				Token:            "token",
+				User:             "user",
+				Priorities:       tt.priorities,
+				ResolvedPriority: &resolvedPriority,
+				Retry:            time.Minute,
+				Expire:           time.Hour,
+			})
+			require.NoError(t, err)
+
+			err = source.Publish(context.Background(), tt.event)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -88,6 +88,8 @@ func (s *SES) Publish(ctx context.Context, e event.Event) error {
 		description = fmt.Sprintf("%s\n\nGo to docs: %s", description, docURL)
 	}
 
+	emoji := event.EmojiPrefix(e)
+
 	input := &ses.SendEmailInput{
 		Destination: &ses.Destination{
 			ToAddresses: aws.StringSlice(s.config.To),
@@ -101,7 +103,7 @@ func (s *SES) Publish(ctx context.Context, e event.Event) error {
 			},
 			Subject: &ses.Content{
 				Charset: aws.String("UTF-8"),
-				Data:    aws.String(fmt.Sprintf("🚨 %s", e.GetTitle(s.includeMonitorName, s.includeGroupName))),
+				Data:    aws.String(fmt.Sprintf("%s%s", emoji, e.GetTitle(s.includeMonitorName, s.includeGroupName))),
 			},
 		},
 		Source: aws.String(s.config.From),
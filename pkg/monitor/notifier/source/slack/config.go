@@ -0,0 +1,32 @@
+package slack
+
+import "errors"
+
+type Config struct {
+	// Webhook is an incoming webhook url, used instead of a bot token.
+	Webhook string `yaml:"webhook"`
+	// BotToken is used to post messages with chat.postMessage.
+	BotToken string `yaml:"botToken"`
+	// Channel is the channel id to post to, required with a bot token.
+	Channel string `yaml:"channel"`
+	// ThreadTS is the optional parent message timestamp to reply in a thread.
+	ThreadTS string `yaml:"threadTs"`
+	// APIURL is the Slack Web API base url.
+	APIURL string `yaml:"apiUrl" default:"https://slack.com/api"`
+}
+
+func (c *Config) Validate() error {
+	if c.Webhook == "" && c.BotToken == "" {
+		return errors.New("webhook or botToken is required")
+	}
+
+	if c.Webhook != "" && c.BotToken != "" {
+		return errors.New("only one of webhook or botToken can be set")
+	}
+
+	if c.BotToken != "" && c.Channel == "" {
+		return errors.New("channel is required when using botToken")
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package slack_test
+
+import (
+	"testing"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/slack"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      *slack.Config
+		expectError bool
+	}{
+		{
+			name: "valid webhook config",
+			config: &slack.Config{
+				Webhook: "https://hooks.slack.com/services/test",
+			},
+			expectError: false,
+		},
+		{
+			name: "valid bot token config",
+			config: &slack.Config{
+				BotToken: "xoxb-test",
+				Channel:  "C123",
+			},
+			expectError: false,
+		},
+		{
+			name:        "empty config",
+			config:      &slack.Config{},
+			expectError: true,
+		},
+		{
+			name: "webhook and bot token",
+			config: &slack.Config{
+				Webhook:  "https://hooks.slack.com/services/test",
+				BotToken: "xoxb-test",
+				Channel:  "C123",
+			},
+			expectError: true,
+		},
+		{
+			name: "bot token without channel",
+			config: &slack.Config{
+				BotToken: "xoxb-test",
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	SourceType = "slack"
+
+	// maxSectionLength is the maximum length of a section block text.
+	maxSectionLength = 3000
+)
+
+var markdownLink = regexp.MustCompile(`\[([^\]]+)\]\(([^)]+)\)`)
+
+type Slack struct {
+	log     logrus.FieldLogger
+	name    string
+	config  *Config
+	client  *http.Client
+	metrics *Metrics
+
+	includeMonitorName bool
+	includeGroupName   bool
+	docs               *string
+}
+
+type apiResponse struct {
+	OK    bool   `json:"ok"`
+	Error string `json:"error"`
+}
+
+func NewSlack(ctx context.Context, log logrus.FieldLogger, monitor, name string, docs *string, includeMonitorName, includeGroupName bool, config *Config) (*Slack, error) {
+	return &Slack{
+		log:                log.WithField("source", name),
+		name:               name,
+		config:             config,
+		client:             &http.Client{},
+		metrics:            GetMetricsInstance("splitoor_notifier_slack", monitor),
+		includeMonitorName: includeMonitorName,
+		includeGroupName:   includeGroupName,
+		docs:               docs,
+	}, nil
+}
+
+func (c *Slack) Start(ctx context.Context) error {
+	return nil
+}
+
+func (c *Slack) Stop(ctx context.Context) error {
+	return nil
+}
+
+func (c *Slack) GetType() string {
+	return SourceType
+}
+
+func (c *Slack) GetName() string {
+	return c.name
+}
+
+func (c *Slack) GetConfig() *Config {
+	return c.config
+}
+
+func (c *Slack) Publish(ctx context.Context, e event.Event) error {
+	log := c.log.WithField("group", e.GetGroup())
+	log.Debug("Publishing message to Slack")
+
+	var errorType string
+
+	var statusCode string
+
+	defer func() {
+		if errorType != "" {
+			c.metrics.IncErrors(e.GetGroup(), c.name, c.GetType(), errorType, statusCode)
+		} else {
+			c.metrics.IncMessagesPublished(e.GetGroup(), c.name, c.GetType())
+		}
+	}()
+
+	jsonData, err := json.Marshal(c.message(e))
+	if err != nil {
+		errorType = "marshal_error"
+
+		return fmt.Errorf("failed to marshal slack message: %w", err)
+	}
+
+	endpoint := c.config.Webhook
+	if c.config.BotToken != "" {
+		endpoint = strings.TrimSuffix(c.config.APIURL, "/") + "/chat.postMessage"
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewBuffer(jsonData))
+	if err != nil {
+		errorType = "request_error"
+
+		return fmt.Errorf("failed to create slack request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	if c.config.BotToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.config.BotToken)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		errorType = "send_error"
+
+		return fmt.Errorf("failed to send slack message: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		statusCode = strconv.Itoa(resp.StatusCode)
+		errorType = "status_error"
+
+		log.WithField("status_code", resp.StatusCode).Error("Slack returned non-2xx status code")
+
+		return fmt.Errorf("slack returned non-2xx status code: %d", resp.StatusCode)
+	}
+
+	// the web api returns 200 with ok=false on errors
+	if c.config.BotToken != "" {
+		var result apiResponse
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			errorType = "decode_error"
+
+			return fmt.Errorf("failed to decode slack response: %w", err)
+		}
+
+		if !result.OK {
+			errorType = "api_error"
+
+			return fmt.Errorf("slack api returned error: %s", result.Error)
+		}
+	}
+
+	log.Debug("Successfully published message to Slack")
+
+	return nil
+}
+
+func (c *Slack) message(e event.Event) map[string]interface{} {
+	emoji := event.EmojiPrefix(e)
+
+	title := fmt.Sprintf("%s%s", emoji, e.GetTitle(c.includeMonitorName, c.includeGroupName))
+
+	description := ToMrkdwn(e.GetDescriptionMarkdown(c.includeMonitorName, c.includeGroupName))
+	if runes := []rune(description); len(runes) > maxSectionLength {
+		description = string(runes[:maxSectionLength-3]) + "..."
+	}
+
+	blocks := []map[string]interface{}{
+		{
+			"type": "header",
+			"text": map[string]interface{}{
+				"type":  "plain_text",
+				"text":  title,
+				"emoji": true,
+			},
+		},
+		{
+			"type": "section",
+			"text": map[string]interface{}{
+				"type": "mrkdwn",
+				"text": description,
+			},
+		},
+	}
+
+	if c.docs != nil {
+		docURL := strings.ReplaceAll(*c.docs, ":group", url.QueryEscape(e.GetGroup()))
+
+		blocks = append(blocks, map[string]interface{}{
+			"type": "context",
+			"elements": []map[string]interface{}{
+				{
+					"type": "mrkdwn",
+					"text": fmt.Sprintf("<%s|*Go to docs*>", docURL),
+				},
+			},
+		})
+	}
+
+	message := map[string]interface{}{
+		"text":   title,
+		"blocks": blocks,
+	}
+
+	if c.config.BotToken != "" {
+		message["channel"] = c.config.Channel
+
+		if c.config.ThreadTS != "" {
+			message["thread_ts"] = c.config.ThreadTS
+		}
+	}
+
+	return message
+}
+
+// ToMrkdwn converts the markdown used by event descriptions into Slack mrkdwn.
+func ToMrkdwn(markdown string) string {
+	text := markdownLink.ReplaceAllString(markdown, "<$2|$1>")
+
+	return strings.ReplaceAll(text, "**", "*")
+}
@@ -0,0 +1,198 @@
+package slack_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/slack"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockEvent struct {
+	mock.Mock
+}
+
+func (m *MockEvent) GetMonitor() string {
+	args := m.Called()
+
+	return args.String(0)
+}
+
+func (m *MockEvent) GetType() string {
+	args := m.Called()
+
+	return args.String(0)
+}
+
+func (m *MockEvent) GetGroup() string {
+	args := m.Called()
+
+	return args.String(0)
+}
+
+func (m *MockEvent) GetTitle(includeMonitorName, includeGroupName bool) string {
+	args := m.Called(includeMonitorName, includeGroupName)
+
+	return args.String(0)
+}
+
+func (m *MockEvent) GetDescriptionText(includeMonitorName, includeGroupName bool) string {
+	args := m.Called(includeMonitorName, includeGroupName)
+
+	return args.String(0)
+}
+
+func (m *MockEvent) GetDescriptionMarkdown(includeMonitorName, includeGroupName bool) string {
+	args := m.Called(includeMonitorName, includeGroupName)
+
+	return args.String(0)
+}
+
+func (m *MockEvent) GetDescriptionHTML(includeMonitorName, includeGroupName bool) string {
+	args := m.Called(includeMonitorName, includeGroupName)
+
+	return args.String(0)
+}
+
+func (m *MockEvent) GetSeverity() event.Severity {
+	args := m.Called()
+
+	return args.Get(0).(event.Severity)
+}
+
+func (m *MockEvent) GetPayload() *event.Payload {
+	args := m.Called()
+
+	return args.Get(0).(*event.Payload)
+}
+
+func TestToMrkdwn(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		want     string
+	}{
+		{
+			name:     "bold",
+			markdown: "**Group:** test",
+			want:     "*Group:* test",
+		},
+		{
+			name:     "link",
+			markdown: "[**Go to docs**](https://example.com/test)",
+			want:     "<https://example.com/test|*Go to docs*>",
+		},
+		{
+			name:     "code",
+			markdown: "**Split:** `0x123`",
+			want:     "*Split:* `0x123`",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, slack.ToMrkdwn(tt.markdown))
+		})
+	}
+}
+
+func TestSlackPublish(t *testing.T) {
+	docs := "https://docs.example.com/:group"
+
+	tests := []struct {
+		name           string
+		botToken       bool
+		serverResponse int
+		responseBody   string
+		expectError    bool
+	}{
+		{
+			name:           "webhook success",
+			serverResponse: http.StatusOK,
+			responseBody:   "ok",
+		},
+		{
+			name:           "webhook server error",
+			serverResponse: http.StatusInternalServerError,
+			expectError:    true,
+		},
+		{
+			name:           "bot token success",
+			botToken:       true,
+			serverResponse: http.StatusOK,
+			responseBody:   `{"ok":true}`,
+		},
+		{
+			name:           "bot token api error",
+			botToken:       true,
+			serverResponse: http.StatusOK,
+			responseBody:   `{"ok":false,"error":"channel_not_found"}`,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+
+				var message map[string]interface{}
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&message))
+				assert.Equal(t, "🚨 Test Title", message["text"])
+
+				blocks, ok := message["blocks"].([]interface{})
+				assert.True(t, ok)
+				assert.Len(t, blocks, 3)
+
+				if tt.botToken {
+					assert.Equal(t, "/chat.postMessage", r.URL.Path)
+					assert.Equal(t, "Bearer xoxb-test", r.Header.Get("Authorization"))
+					assert.Equal(t, "C123", message["channel"])
+					assert.Equal(t, "1700000000.000100", message["thread_ts"])
+				} else {
+					assert.Empty(t, r.Header.Get("Authorization"))
+					assert.Nil(t, message["channel"])
+				}
+
+				w.WriteHeader(tt.serverResponse)
+				_, _ = w.Write([]byte(tt.responseBody))
+			}))
+			defer server.Close()
+
+			config := &slack.Config{Webhook: server.URL}
+			if tt.botToken {
+				config = &slack.Config{
+					BotToken: "xoxb-test",
+					Channel:  "C123",
+					ThreadTS: "1700000000.000100",
+					APIURL:   server.URL,
+				}
+			}
+
+			s, err := slack.NewSlack(context.Background(), logrus.New(), "test", "test_source", &docs, true, true, config)
+			require.NoError(t, err)
+
+			mockEvent := new(MockEvent)
+			mockEvent.On("GetGroup").Return("test_group")
+			mockEvent.On("GetTitle", true, true).Return("Test Title")
+			mockEvent.On("GetDescriptionMarkdown", true, true).Return("**Group:** test_group")
+
+			err = s.Publish(context.Background(), mockEvent)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockEvent.AssertExpectations(t)
+		})
+	}
+}
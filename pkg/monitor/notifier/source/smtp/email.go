@@ -77,7 +77,9 @@ func (s *SMTP) Publish(ctx context.Context, evt event.Event) error {
 		description = fmt.Sprintf("%s\n\nGo to docs: %s", description, docURL)
 	}
 
-	if err := s.sendEmail(evt, fmt.Sprintf("🚨 %s", evt.GetTitle(s.includeMonitorName, s.includeGroupName)), description); err != nil {
+	emoji := event.EmojiPrefix(evt)
+
+	if err := s.sendEmail(evt, fmt.Sprintf("%s%s", emoji, evt.GetTitle(s.includeMonitorName, s.includeGroupName)), description); err != nil {
 		return err
 	}
 
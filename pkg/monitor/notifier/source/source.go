@@ -6,10 +6,20 @@ import (
 
 	"github.com/creasty/defaults"
 	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/alertmanager"
 	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/discord"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/gotify"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/matrix"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/ntfy"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/opsgenie"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/pagerduty"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/pushover"
 	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/ses"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/slack"
 	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/smtp"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/teams"
 	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/telegram"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/webhook"
 
 	"github.com/sirupsen/logrus"
 )
@@ -100,6 +110,186 @@ func NewSource(ctx context.Context, log logrus.FieldLogger, monitor, sourceName
 		}
 
 		return telegram.NewTelegram(ctx, log, monitor, sourceName, docs, includeMonitorName, includeGroupName, conf)
+	case SourceTypeWebhook:
+		conf := &webhook.Config{}
+
+		if config != nil {
+			if err := config.Unmarshal(conf); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := defaults.Set(conf); err != nil {
+			return nil, err
+		}
+
+		if err := conf.Validate(); err != nil {
+			return nil, err
+		}
+
+		return webhook.NewWebhook(ctx, log, monitor, sourceName, docs, includeMonitorName, includeGroupName, conf)
+	case SourceTypeSlack:
+		conf := &slack.Config{}
+
+		if config != nil {
+			if err := config.Unmarshal(conf); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := defaults.Set(conf); err != nil {
+			return nil, err
+		}
+
+		if err := conf.Validate(); err != nil {
+			return nil, err
+		}
+
+		return slack.NewSlack(ctx, log, monitor, sourceName, docs, includeMonitorName, includeGroupName, conf)
+	case SourceTypePagerDuty:
+		conf := &pagerduty.Config{}
+
+		if config != nil {
+			if err := config.Unmarshal(conf); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := defaults.Set(conf); err != nil {
+			return nil, err
+		}
+
+		if err := conf.Validate(); err != nil {
+			return nil, err
+		}
+
+		return pagerduty.NewPagerDuty(ctx, log, monitor, sourceName, docs, includeMonitorName, includeGroupName, conf)
+	case SourceTypeAlertmanager:
+		conf := &alertmanager.Config{}
+
+		if config != nil {
+			if err := config.Unmarshal(conf); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := defaults.Set(conf); err != nil {
+			return nil, err
+		}
+
+		if err := conf.Validate(); err != nil {
+			return nil, err
+		}
+
+		return alertmanager.NewAlertmanager(ctx, log, monitor, sourceName, docs, includeMonitorName, includeGroupName, conf)
+	case SourceTypeOpsgenie:
+		conf := &opsgenie.Config{}
+
+		if config != nil {
+			if err := config.Unmarshal(conf); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := defaults.Set(conf); err != nil {
+			return nil, err
+		}
+
+		if err := conf.Validate(); err != nil {
+			return nil, err
+		}
+
+		return opsgenie.NewOpsgenie(ctx, log, monitor, sourceName, docs, includeMonitorName, includeGroupName, conf)
+	case SourceTypeMatrix:
+		conf := &matrix.Config{}
+
+		if config != nil {
+			if err := config.Unmarshal(conf); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := defaults.Set(conf); err != nil {
+			return nil, err
+		}
+
+		if err := conf.Validate(); err != nil {
+			return nil, err
+		}
+
+		return matrix.NewMatrix(ctx, log, monitor, sourceName, docs, includeMonitorName, includeGroupName, conf)
+	case SourceTypeTeams:
+		conf := &teams.Config{}
+
+		if config != nil {
+			if err := config.Unmarshal(conf); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := defaults.Set(conf); err != nil {
+			return nil, err
+		}
+
+		if err := conf.Validate(); err != nil {
+			return nil, err
+		}
+
+		return teams.NewTeams(ctx, log, monitor, sourceName, docs, includeMonitorName, includeGroupName, conf)
+	case SourceTypeNtfy:
+		conf := &ntfy.Config{}
+
+		if config != nil {
+			if err := config.Unmarshal(conf); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := defaults.Set(conf); err != nil {
+			return nil, err
+		}
+
+		if err := conf.Validate(); err != nil {
+			return nil, err
+		}
+
+		return ntfy.NewNtfy(ctx, log, monitor, sourceName, docs, includeMonitorName, includeGroupName, conf)
+	case SourceTypeGotify:
+		conf := &gotify.Config{}
+
+		if config != nil {
+			if err := config.Unmarshal(conf); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := defaults.Set(conf); err != nil {
+			return nil, err
+		}
+
+		if err := conf.Validate(); err != nil {
+			return nil, err
+		}
+
+		return gotify.NewGotify(ctx, log, monitor, sourceName, docs, includeMonitorName, includeGroupName, conf)
+	case SourceTypePushover:
+		conf := &pushover.Config{}
+
+		if config != nil {
+			if err := config.Unmarshal(conf); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := defaults.Set(conf); err != nil {
+			return nil, err
+		}
+
+		if err := conf.Validate(); err != nil {
+			return nil, err
+		}
+
+		return pushover.NewPushover(ctx, log, monitor, sourceName, docs, includeMonitorName, includeGroupName, conf)
 	}
 
 	return nil, errors.New("source type is not supported")
@@ -0,0 +1,209 @@
+package teams
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+)
+
+const (
+	adaptiveCardContentType = "application/vnd.microsoft.card.adaptive"
+	adaptiveCardSchema      = "http://adaptivecards.io/schemas/adaptive-card.json"
+	adaptiveCardVersion     = "1.4"
+)
+
+// Message is the webhook payload wrapping an Adaptive Card.
+type Message struct {
+	Type        string        `json:"type"`
+	Attachments []*Attachment `json:"attachments"`
+}
+
+type Attachment struct {
+	ContentType string `json:"contentType"`
+	Content     *Card  `json:"content"`
+}
+
+type Card struct {
+	Schema  string        `json:"$schema"`
+	Type    string        `json:"type"`
+	Version string        `json:"version"`
+	MSTeams *CardMSTeams  `json:"msteams,omitempty"`
+	Body    []interface{} `json:"body"`
+	Actions []*Action     `json:"actions,omitempty"`
+}
+
+type CardMSTeams struct {
+	Width string `json:"width"`
+}
+
+type TextBlock struct {
+	Type   string `json:"type"`
+	Text   string `json:"text"`
+	Weight string `json:"weight,omitempty"`
+	Size   string `json:"size,omitempty"`
+	Color  string `json:"color,omitempty"`
+	Wrap   bool   `json:"wrap"`
+}
+
+type FactSet struct {
+	Type  string  `json:"type"`
+	Facts []*Fact `json:"facts"`
+}
+
+type Fact struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+}
+
+type Action struct {
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+// NewMessage builds an Adaptive Card message for an event.
+func NewMessage(e event.Event, includeMonitorName, includeGroupName bool, docURL string) *Message {
+	emoji := event.EmojiPrefix(e)
+	color := "Attention"
+
+	switch {
+	case event.IsResolved(e):
+		color = "Good"
+	case event.IsDigest(e):
+		color = "Accent"
+	}
+
+	// digests don't map to a flat list of facts and templated events bring their own
+	// layout, so both are rendered as markdown instead
+	var details interface{} = &FactSet{
+		Type:  "FactSet",
+		Facts: Facts(e, includeMonitorName, includeGroupName),
+	}
+
+	if _, templated := e.(event.Wrapper); templated || event.IsDigest(e) {
+		details = &TextBlock{
+			Type: "TextBlock",
+			Text: e.GetDescriptionMarkdown(includeMonitorName, includeGroupName),
+			Wrap: true,
+		}
+	}
+
+	card := &Card{
+		Schema:  adaptiveCardSchema,
+		Type:    "AdaptiveCard",
+		Version: adaptiveCardVersion,
+		MSTeams: &CardMSTeams{Width: "Full"},
+		Body: []interface{}{
+			&TextBlock{
+				Type:   "TextBlock",
+				Text:   fmt.Sprintf("%s%s", emoji, e.GetTitle(includeMonitorName, includeGroupName)),
+				Weight: "Bolder",
+				Size:   "Medium",
+				Color:  color,
+				Wrap:   true,
+			},
+			details,
+		},
+	}
+
+	if docURL != "" {
+		card.Actions = []*Action{
+			{
+				Type:  "Action.OpenUrl",
+				Title: "Go to docs",
+				URL:   docURL,
+			},
+		}
+	}
+
+	return &Message{
+		Type: "message",
+		Attachments: []*Attachment{
+			{
+				ContentType: adaptiveCardContentType,
+				Content:     card,
+			},
+		},
+	}
+}
+
+// Facts returns a fact for each exported field of the event, in declaration order.
+// Reminders return the facts of the alert that is still firing.
+func Facts(e event.Event, includeMonitorName, includeGroupName bool) []*Fact {
+	e = event.Unwrap(e)
+
+	if r, ok := e.(*event.Reminder); ok {
+		return append(Facts(r.Event, includeMonitorName, includeGroupName), &Fact{
+			Title: "Still Firing Since",
+			Value: formatValue(r.Since),
+		})
+	}
+
+	facts := []*Fact{}
+
+	v := reflect.Indirect(reflect.ValueOf(e))
+	if v.Kind() != reflect.Struct {
+		return facts
+	}
+
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		if (field.Name == "Monitor" && !includeMonitorName) || (field.Name == "Group" && !includeGroupName) {
+			continue
+		}
+
+		value := formatValue(v.Field(i).Interface())
+		if value == "" {
+			continue
+		}
+
+		facts = append(facts, &Fact{
+			Title: humanize(field.Name),
+			Value: value,
+		})
+	}
+
+	return facts
+}
+
+func formatValue(value interface{}) string {
+	if t, ok := value.(time.Time); ok {
+		if t.IsZero() {
+			return ""
+		}
+
+		return t.UTC().Format("2006-01-02 15:04:05 UTC")
+	}
+
+	return fmt.Sprint(value)
+}
+
+// humanize splits a field name into words, eg. RecoveryTransactionID becomes "Recovery Transaction ID".
+func humanize(name string) string {
+	runes := []rune(name)
+
+	var sb strings.Builder
+
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prevLower := unicode.IsLower(runes[i-1])
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+
+			if prevLower || (unicode.IsUpper(runes[i-1]) && nextLower) {
+				sb.WriteRune(' ')
+			}
+		}
+
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,16 @@
+package teams
+
+import "errors"
+
+type Config struct {
+	// Webhook is the url of a Teams workflow ("Post to a channel when a webhook request is received") or incoming webhook.
+	Webhook string `yaml:"webhook"`
+}
+
+func (c *Config) Validate() error {
+	if c.Webhook == "" {
+		return errors.New("webhook is required")
+	}
+
+	return nil
+}
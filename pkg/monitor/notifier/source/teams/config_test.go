@@ -0,0 +1,40 @@
+package teams_test
+
+import (
+	"testing"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/teams"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      *teams.Config
+		expectError bool
+	}{
+		{
+			name: "valid config",
+			config: &teams.Config{
+				Webhook: "https://example.com/webhook",
+			},
+			expectError: false,
+		},
+		{
+			name:        "missing webhook",
+			config:      &teams.Config{},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package teams
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/sirupsen/logrus"
+)
+
+const SourceType = "teams"
+
+type Teams struct {
+	log     logrus.FieldLogger
+	name    string
+	config  *Config
+	client  *http.Client
+	metrics *Metrics
+
+	includeMonitorName bool
+	includeGroupName   bool
+	docs               *string
+}
+
+func NewTeams(ctx context.Context, log logrus.FieldLogger, monitor, name string, docs *string, includeMonitorName, includeGroupName bool, config *Config) (*Teams, error) {
+	return &Teams{
+		log:                log.WithField("source", name),
+		name:               name,
+		config:             config,
+		client:             &http.Client{},
+		metrics:            GetMetricsInstance("splitoor_notifier_teams", monitor),
+		includeMonitorName: includeMonitorName,
+		includeGroupName:   includeGroupName,
+		docs:               docs,
+	}, nil
+}
+
+func (c *Teams) Start(ctx context.Context) error {
+	return nil
+}
+
+func (c *Teams) Stop(ctx context.Context) error {
+	return nil
+}
+
+func (c *Teams) GetType() string {
+	return SourceType
+}
+
+func (c *Teams) GetName() string {
+	return c.name
+}
+
+func (c *Teams) GetConfig() *Config {
+	return c.config
+}
+
+func (c *Teams) Publish(ctx context.Context, e event.Event) error {
+	log := c.log.WithField("group", e.GetGroup())
+	log.Debug("Publishing message to Teams")
+
+	var errorType string
+
+	var statusCode string
+
+	defer func() {
+		if errorType != "" {
+			c.metrics.IncErrors(e.GetGroup(), c.name, c.GetType(), errorType, statusCode)
+		} else {
+			c.metrics.IncMessagesPublished(e.GetGroup(), c.name, c.GetType())
+		}
+	}()
+
+	var docURL string
+	if c.docs != nil {
+		docURL = strings.ReplaceAll(*c.docs, ":group", url.QueryEscape(e.GetGroup()))
+	}
+
+	jsonData, err := json.Marshal(NewMessage(e, c.includeMonitorName, c.includeGroupName, docURL))
+	if err != nil {
+		errorType = "marshal_error"
+
+		return fmt.Errorf("failed to marshal teams message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.config.Webhook, bytes.NewBuffer(jsonData))
+	if err != nil {
+		errorType = "request_error"
+
+		return fmt.Errorf("failed to create teams request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		errorType = "send_error"
+
+		return fmt.Errorf("failed to send teams message: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	// incoming webhooks respond with 200, workflows with 202
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		statusCode = strconv.Itoa(resp.StatusCode)
+		errorType = "status_error"
+
+		log.WithField("status_code", resp.StatusCode).Error("Teams returned non-2xx status code")
+
+		return fmt.Errorf("teams returned non-2xx status code: %d", resp.StatusCode)
+	}
+
+	log.Debug("Successfully published message to Teams")
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package teams_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/safe"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/split"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/teams"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFacts(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name               string
+		event              event.Event
+		includeMonitorName bool
+		includeGroupName   bool
+		want               []*teams.Fact
+	}{
+		{
+			name:               "all fields",
+			event:              split.NewController(now, "monitor", "group", "0x123", "0x456", "0x789"),
+			includeMonitorName: true,
+			includeGroupName:   true,
+			want: []*teams.Fact{
+				{Title: "Timestamp", Value: "2024-01-01 12:00:00 UTC"},
+				{Title: "Split Address", Value: "0x123"},
+				{Title: "Expected Controller", Value: "0x456"},
+				{Title: "Actual Controller", Value: "0x789"},
+				{Title: "Group", Value: "group"},
+				{Title: "Monitor", Value: "monitor"},
+			},
+		},
+		{
+			name:  "without monitor and group",
+			event: safe.NewRecoveryTransactionNotNext(now, "monitor", "group", "0xsafe", "tx"),
+			want: []*teams.Fact{
+				{Title: "Timestamp", Value: "2024-01-01 12:00:00 UTC"},
+				{Title: "Safe Address", Value: "0xsafe"},
+				{Title: "Recovery Transaction ID", Value: "tx"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, teams.Facts(tt.event, tt.includeMonitorName, tt.includeGroupName))
+		})
+	}
+}
+
+func TestTeamsPublish(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	docs := "https://docs.example.com/:group"
+
+	tests := []struct {
+		name           string
+		event          event.Event
+		serverResponse int
+		wantColor      string
+		expectError    bool
+	}{
+		{
+			name:           "alert",
+			event:          split.NewHashUnknownState(now, "monitor", "group", "0x123", "expected", "actual"),
+			serverResponse: http.StatusAccepted,
+			wantColor:      "Attention",
+		},
+		{
+			name:           "resolved",
+			event:          event.NewResolved(now, now.Add(-time.Hour), "monitor", "group", split.HashUnknownStateType, split.HashUnknownStateSeverity, "Split hash is in unknown state", "0x123"),
+			serverResponse: http.StatusOK,
+			wantColor:      "Good",
+		},
+		{
+			name:           "server error",
+			event:          split.NewHashUnknownState(now, "monitor", "group", "0x123", "expected", "actual"),
+			serverResponse: http.StatusBadRequest,
+			wantColor:      "Attention",
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+				var raw map[string]interface{}
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&raw))
+
+				attachments, ok := raw["attachments"].([]interface{})
+				require.True(t, ok)
+				require.Len(t, attachments, 1)
+
+				attachment := attachments[0].(map[string]interface{})
+				assert.Equal(t, "application/vnd.microsoft.card.adaptive", attachment["contentType"])
+
+				card := attachment["content"].(map[string]interface{})
+				assert.Equal(t, "AdaptiveCard", card["type"])
+
+				body := card["body"].([]interface{})
+				require.Len(t, body, 2)
+
+				title := body[0].(map[string]interface{})
+				assert.Equal(t, tt.wantColor, title["color"])
+				assert.Contains(t, title["text"], tt.event.GetTitle(true, true))
+
+				facts := body[1].(map[string]interface{})
+				assert.Equal(t, "FactSet", facts["type"])
+				assert.NotEmpty(t, facts["facts"])
+
+				actions := card["actions"].([]interface{})
+				require.Len(t, actions, 1)
+				assert.Equal(t, "https://docs.example.com/group", actions[0].(map[string]interface{})["url"])
+
+				w.WriteHeader(tt.serverResponse)
+			}))
+			defer server.Close()
+
+			source, err := teams.NewTeams(context.Background(), logrus.New(), "monitor", "test_source", &docs, true, true, &teams.Config{
+				Webhook: server.URL,
+			})
+			require.NoError(t, err)
+
+			err = source.Publish(context.Background(), tt.event)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
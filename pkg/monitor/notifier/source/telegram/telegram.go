@@ -109,7 +109,10 @@ func (t *Telegram) Publish(ctx context.Context, e event.Event) error {
 
 	title := strings.ReplaceAll(e.GetTitle(t.includeMonitorName, t.includeGroupName), "-", "\\-")
 	description = strings.ReplaceAll(strings.ReplaceAll(description, "**", "***"), "-", "\\-")
-	text := fmt.Sprintf("🚨 ***%s***\n\n%s", title, description)
+
+	emoji := event.EmojiPrefix(e)
+
+	text := fmt.Sprintf("%s***%s***\n\n%s", emoji, title, description)
 
 	isDisabled := true
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
 	tel "github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/telegram"
 	"github.com/go-telegram/bot"
 	"github.com/go-telegram/bot/models"
@@ -60,6 +61,18 @@ func (m *MockEvent) GetDescriptionHTML(includeMonitorName, includeGroupName bool
 	return args.String(0)
 }
 
+func (m *MockEvent) GetSeverity() event.Severity {
+	args := m.Called()
+
+	return args.Get(0).(event.Severity)
+}
+
+func (m *MockEvent) GetPayload() *event.Payload {
+	args := m.Called()
+
+	return args.Get(0).(*event.Payload)
+}
+
 type MockBot struct {
 	mock.Mock
 }
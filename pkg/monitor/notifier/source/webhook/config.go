@@ -0,0 +1,30 @@
+package webhook
+
+import (
+	"errors"
+	"net/url"
+	"time"
+)
+
+type Config struct {
+	URL     string            `yaml:"url"`
+	Headers map[string]string `yaml:"headers"`
+	Secret  string            `yaml:"secret"`
+	Timeout time.Duration     `yaml:"timeout" default:"10s"`
+}
+
+func (c *Config) Validate() error {
+	if c.URL == "" {
+		return errors.New("url is required")
+	}
+
+	if _, err := url.ParseRequestURI(c.URL); err != nil {
+		return errors.New("url is invalid")
+	}
+
+	if c.Timeout <= 0 {
+		return errors.New("timeout must be greater than 0")
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package webhook_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/webhook"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      *webhook.Config
+		expectError bool
+	}{
+		{
+			name: "valid config",
+			config: &webhook.Config{
+				URL:     "https://example.com/hook",
+				Timeout: 10 * time.Second,
+			},
+			expectError: false,
+		},
+		{
+			name: "empty url",
+			config: &webhook.Config{
+				Timeout: 10 * time.Second,
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid url",
+			config: &webhook.Config{
+				URL:     "not a url",
+				Timeout: 10 * time.Second,
+			},
+			expectError: true,
+		},
+		{
+			name: "zero timeout",
+			config: &webhook.Config{
+				URL: "https://example.com/hook",
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	SourceType = "webhook"
+
+	// PayloadVersion is incremented on breaking changes to the payload.
+	PayloadVersion = 1
+
+	SignatureHeader = "X-Splitoor-Signature"
+	EventTypeHeader = "X-Splitoor-Event"
+)
+
+// Payload is the JSON document sent for every event.
+type Payload struct {
+	Version     int             `json:"version"`
+	Type        string          `json:"type"`
+	Monitor     string          `json:"monitor"`
+	Group       string          `json:"group"`
+	Resolved    bool            `json:"resolved"`
+	Title       string          `json:"title"`
+	Description Description     `json:"description"`
+	Timestamp   time.Time       `json:"timestamp"`
+	Docs        string          `json:"docs,omitempty"`
+	Fields      json.RawMessage `json:"fields,omitempty"`
+	// Event is the versioned machine-readable representation of the event.
+	Event *event.Payload `json:"event"`
+}
+
+type Description struct {
+	Text     string `json:"text"`
+	Markdown string `json:"markdown"`
+	HTML     string `json:"html"`
+}
+
+type Webhook struct {
+	log     logrus.FieldLogger
+	name    string
+	config  *Config
+	client  *http.Client
+	metrics *Metrics
+
+	includeMonitorName bool
+	includeGroupName   bool
+	docs               *string
+}
+
+func NewWebhook(ctx context.Context, log logrus.FieldLogger, monitor, name string, docs *string, includeMonitorName, includeGroupName bool, config *Config) (*Webhook, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Webhook{
+		log:     log.WithField("source", name),
+		name:    name,
+		config:  config,
+		client:  &http.Client{Timeout: config.Timeout},
+		metrics: GetMetricsInstance("splitoor_notifier_webhook", monitor),
+
+		includeMonitorName: includeMonitorName,
+		includeGroupName:   includeGroupName,
+		docs:               docs,
+	}, nil
+}
+
+func (c *Webhook) Start(ctx context.Context) error {
+	return nil
+}
+
+func (c *Webhook) Stop(ctx context.Context) error {
+	return nil
+}
+
+func (c *Webhook) GetType() string {
+	return SourceType
+}
+
+func (c *Webhook) GetName() string {
+	return c.name
+}
+
+func (c *Webhook) GetConfig() *Config {
+	return c.config
+}
+
+func (c *Webhook) Publish(ctx context.Context, e event.Event) error {
+	log := c.log.WithField("group", e.GetGroup())
+	log.Debug("Publishing message to webhook")
+
+	var errorType string
+
+	var statusCode string
+
+	defer func() {
+		if errorType != "" {
+			c.metrics.IncErrors(e.GetGroup(), c.name, c.GetType(), errorType, statusCode)
+		} else {
+			c.metrics.IncMessagesPublished(e.GetGroup(), c.name, c.GetType())
+		}
+	}()
+
+	jsonData, err := json.Marshal(c.payload(e))
+	if err != nil {
+		errorType = "marshal_error"
+
+		return fmt.Errorf("failed to marshal webhook payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.config.URL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		errorType = "request_error"
+
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventTypeHeader, e.GetType())
+
+	for key, value := range c.config.Headers {
+		req.Header.Set(key, value)
+	}
+
+	if c.config.Secret != "" {
+		req.Header.Set(SignatureHeader, "sha256="+Sign(c.config.Secret, jsonData))
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		errorType = "send_error"
+
+		return fmt.Errorf("failed to send webhook: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		statusCode = strconv.Itoa(resp.StatusCode)
+		errorType = "status_error"
+
+		log.WithField("status_code", resp.StatusCode).Error("Webhook returned non-2xx status code")
+
+		return fmt.Errorf("webhook returned non-2xx status code: %d", resp.StatusCode)
+	}
+
+	log.Debug("Successfully published message to webhook")
+
+	return nil
+}
+
+func (c *Webhook) payload(e event.Event) *Payload {
+	payload := &Payload{
+		Version:  PayloadVersion,
+		Type:     e.GetType(),
+		Monitor:  e.GetMonitor(),
+		Group:    e.GetGroup(),
+		Resolved: event.IsResolved(e),
+		Title:    e.GetTitle(c.includeMonitorName, c.includeGroupName),
+		Description: Description{
+			Text:     e.GetDescriptionText(c.includeMonitorName, c.includeGroupName),
+			Markdown: e.GetDescriptionMarkdown(c.includeMonitorName, c.includeGroupName),
+			HTML:     e.GetDescriptionHTML(c.includeMonitorName, c.includeGroupName),
+		},
+		Timestamp: time.Now().UTC(),
+		Event:     e.GetPayload(),
+	}
+
+	if c.docs != nil {
+		payload.Docs = strings.ReplaceAll(*c.docs, ":group", url.QueryEscape(e.GetGroup()))
+	}
+
+	// events are plain structs, so their exported fields make up the structured part of the payload
+	fields, err := json.Marshal(e)
+	if err != nil {
+		c.log.WithError(err).WithField("type", e.GetType()).Debug("Unable to marshal event fields")
+	} else {
+		payload.Fields = fields
+	}
+
+	return payload
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of body using secret.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,153 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/split"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/source/webhook"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWebhook(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      *webhook.Config
+		expectError bool
+	}{
+		{
+			name: "valid config",
+			config: &webhook.Config{
+				URL:     "https://example.com/hook",
+				Timeout: time.Second,
+			},
+			expectError: false,
+		},
+		{
+			name:        "invalid config",
+			config:      &webhook.Config{},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, err := webhook.NewWebhook(context.Background(), logrus.New(), "test_monitor", tt.name, nil, true, true, tt.config)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, w)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.name, w.GetName())
+				assert.Equal(t, webhook.SourceType, w.GetType())
+			}
+		})
+	}
+}
+
+func TestWebhookPublish(t *testing.T) {
+	docs := "https://docs.example.com/:group"
+
+	tests := []struct {
+		name           string
+		secret         string
+		serverResponse int
+		expectError    bool
+	}{
+		{
+			name:           "successful publish",
+			serverResponse: http.StatusOK,
+		},
+		{
+			name:           "signed publish",
+			secret:         "supersecret",
+			serverResponse: http.StatusNoContent,
+		},
+		{
+			name:           "server error",
+			serverResponse: http.StatusInternalServerError,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evt := split.NewController(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC), "test_monitor", "test_group", "0x123", "0x456", "0x789")
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				assert.Equal(t, "value", r.Header.Get("X-Custom"))
+				assert.Equal(t, split.ControllerType, r.Header.Get(webhook.EventTypeHeader))
+
+				body, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+
+				if tt.secret != "" {
+					assert.Equal(t, "sha256="+webhook.Sign(tt.secret, body), r.Header.Get(webhook.SignatureHeader))
+				} else {
+					assert.Empty(t, r.Header.Get(webhook.SignatureHeader))
+				}
+
+				var payload webhook.Payload
+				assert.NoError(t, json.Unmarshal(body, &payload))
+				assert.Equal(t, webhook.PayloadVersion, payload.Version)
+				assert.Equal(t, split.ControllerType, payload.Type)
+				assert.Equal(t, "test_monitor", payload.Monitor)
+				assert.Equal(t, "test_group", payload.Group)
+				assert.False(t, payload.Resolved)
+				assert.Equal(t, evt.GetTitle(true, true), payload.Title)
+				assert.Equal(t, evt.GetDescriptionText(true, true), payload.Description.Text)
+				assert.Equal(t, evt.GetDescriptionMarkdown(true, true), payload.Description.Markdown)
+				assert.Equal(t, evt.GetDescriptionHTML(true, true), payload.Description.HTML)
+				assert.Equal(t, "https://docs.example.com/test_group", payload.Docs)
+
+				var fields map[string]interface{}
+				assert.NoError(t, json.Unmarshal(payload.Fields, &fields))
+				assert.Equal(t, "0x123", fields["SplitAddress"])
+
+				require.NotNil(t, payload.Event)
+				assert.Equal(t, event.SchemaVersion, payload.Event.SchemaVersion)
+				assert.Equal(t, split.ControllerType, payload.Event.Type)
+				assert.Equal(t, event.SeverityCritical, payload.Event.Severity)
+				assert.Equal(t, "0x123", payload.Event.Subject)
+
+				data, ok := payload.Event.Data.(map[string]interface{})
+				require.True(t, ok)
+				assert.Equal(t, "0x123", data["splitAddress"])
+
+				w.WriteHeader(tt.serverResponse)
+			}))
+			defer server.Close()
+
+			w, err := webhook.NewWebhook(context.Background(), logrus.New(), "test_monitor", "test_source", &docs, true, true, &webhook.Config{
+				URL:     server.URL,
+				Headers: map[string]string{"X-Custom": "value"},
+				Secret:  tt.secret,
+				Timeout: time.Second,
+			})
+			require.NoError(t, err)
+
+			err = w.Publish(context.Background(), evt)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSign(t *testing.T) {
+	assert.Equal(t, "f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8", webhook.Sign("key", []byte("The quick brown fox jumps over the lazy dog")))
+}
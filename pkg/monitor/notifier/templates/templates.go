@@ -0,0 +1,259 @@
+package templates
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultKey is the event type of templates that apply to every event type without
+// its own templates.
+const DefaultKey = "default"
+
+// Config overrides how an event type is rendered. Every template is optional, events
+// fall back to their built-in rendering for templates that are not set.
+type Config struct {
+	// Title is a text/template for the title.
+	Title string `yaml:"title"`
+	// Text is a text/template for the plain text description.
+	Text string `yaml:"text"`
+	// Markdown is a text/template for the markdown description.
+	Markdown string `yaml:"markdown"`
+	// HTML is an html/template for the HTML description.
+	HTML string `yaml:"html"`
+}
+
+// Data is passed to the templates.
+type Data struct {
+	// Event is the event being rendered, its exported fields are available, eg. {{ .Event.SplitAddress }}.
+	Event     event.Event
+	Monitor   string
+	Group     string
+	Type      string
+	AlertType string
+	Severity  string
+	Subject   string
+	Resolved  bool
+	// Emoji is the emoji sources prefix the built-in title with. A title template replaces
+	// the prefix too, so it is only shown if the template includes it, eg. {{ .Emoji }} {{ .Title }}.
+	Emoji string
+	// Title is the built-in title of the event.
+	Title string
+	// Description is the built-in description of the event in the format being rendered.
+	Description interface{}
+	// IncludeMonitor and IncludeGroup are the settings of the source.
+	IncludeMonitor bool
+	IncludeGroup   bool
+}
+
+type set struct {
+	title    *texttemplate.Template
+	text     *texttemplate.Template
+	markdown *texttemplate.Template
+	html     *htmltemplate.Template
+}
+
+// Templates holds the parsed templates of a source by event type.
+type Templates struct {
+	log  logrus.FieldLogger
+	sets map[string]*set
+}
+
+var funcs = map[string]interface{}{
+	"upper":   strings.ToUpper,
+	"lower":   strings.ToLower,
+	"replace": strings.ReplaceAll,
+	"time": func(t time.Time) string {
+		return t.UTC().Format("2006-01-02 15:04:05 UTC")
+	},
+}
+
+// New parses the templates, keyed by event type, eg. split_controller or
+// split_controller_resolved. Templates under the default key apply to every other
+// event type.
+func New(log logrus.FieldLogger, configs map[string]Config) (*Templates, error) {
+	t := &Templates{
+		log:  log,
+		sets: make(map[string]*set, len(configs)),
+	}
+
+	for eventType, config := range configs {
+		s := &set{}
+
+		var err error
+
+		if s.title, err = parseText(eventType+"/title", config.Title); err != nil {
+			return nil, err
+		}
+
+		if s.text, err = parseText(eventType+"/text", config.Text); err != nil {
+			return nil, err
+		}
+
+		if s.markdown, err = parseText(eventType+"/markdown", config.Markdown); err != nil {
+			return nil, err
+		}
+
+		if config.HTML != "" {
+			if s.html, err = htmltemplate.New(eventType + "/html").Funcs(funcs).Parse(config.HTML); err != nil {
+				return nil, fmt.Errorf("invalid template %s/html: %w", eventType, err)
+			}
+		}
+
+		t.sets[eventType] = s
+	}
+
+	return t, nil
+}
+
+func parseText(name, text string) (*texttemplate.Template, error) {
+	if text == "" {
+		return nil, nil
+	}
+
+	tmpl, err := texttemplate.New(name).Funcs(funcs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template %s: %w", name, err)
+	}
+
+	return tmpl, nil
+}
+
+// Apply returns the event wrapped so it renders with the templates of its type, or the
+// event itself if there are none.
+func (t *Templates) Apply(e event.Event) event.Event {
+	if t == nil {
+		return e
+	}
+
+	s, ok := t.sets[e.GetType()]
+	if !ok {
+		s, ok = t.sets[DefaultKey]
+	}
+
+	if !ok {
+		return e
+	}
+
+	return &Event{
+		Event: e,
+		log:   t.log,
+		set:   s,
+	}
+}
+
+// Event renders the wrapped event with custom templates.
+type Event struct {
+	event.Event
+
+	log logrus.FieldLogger
+	set *set
+}
+
+func (e *Event) Unwrap() event.Event {
+	return e.Event
+}
+
+func (e *Event) GetSubject() string {
+	return event.GetSubject(e.Event)
+}
+
+// MarshalJSON marshals the wrapped event so structured payloads are unchanged.
+func (e *Event) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.Event)
+}
+
+func (e *Event) data(includeMonitor, includeGroup bool, description interface{}) *Data {
+	return &Data{
+		Event:          e.Event,
+		Monitor:        e.Event.GetMonitor(),
+		Group:          e.Event.GetGroup(),
+		Type:           e.Event.GetType(),
+		AlertType:      event.GetAlertType(e.Event),
+		Severity:       string(e.Event.GetSeverity()),
+		Subject:        event.GetSubject(e.Event),
+		Resolved:       event.IsResolved(e.Event),
+		Emoji:          event.Emoji(e.Event),
+		Title:          e.Event.GetTitle(includeMonitor, includeGroup),
+		Description:    description,
+		IncludeMonitor: includeMonitor,
+		IncludeGroup:   includeGroup,
+	}
+}
+
+// GetEmoji returns no emoji if the title is rendered with a template, the template renders
+// the emoji itself.
+func (e *Event) GetEmoji() string {
+	if e.set.title != nil {
+		return ""
+	}
+
+	return event.Emoji(e.Event)
+}
+
+func (e *Event) GetTitle(includeMonitor, includeGroup bool) string {
+	title := e.Event.GetTitle(includeMonitor, includeGroup)
+
+	if e.set.title == nil {
+		return title
+	}
+
+	// sources don't prefix the emoji of a title template, the fallback keeps it
+	return e.execute(e.set.title, e.data(includeMonitor, includeGroup, title), event.EmojiPrefix(e.Event)+title)
+}
+
+func (e *Event) GetDescriptionText(includeMonitor, includeGroup bool) string {
+	description := e.Event.GetDescriptionText(includeMonitor, includeGroup)
+
+	return e.execute(e.set.text, e.data(includeMonitor, includeGroup, description), description)
+}
+
+func (e *Event) GetDescriptionMarkdown(includeMonitor, includeGroup bool) string {
+	description := e.Event.GetDescriptionMarkdown(includeMonitor, includeGroup)
+
+	return e.execute(e.set.markdown, e.data(includeMonitor, includeGroup, description), description)
+}
+
+func (e *Event) GetDescriptionHTML(includeMonitor, includeGroup bool) string {
+	description := e.Event.GetDescriptionHTML(includeMonitor, includeGroup)
+
+	if e.set.html == nil {
+		return description
+	}
+
+	//nolint:gosec // the built-in description is generated HTML
+	data := e.data(includeMonitor, includeGroup, htmltemplate.HTML(description))
+
+	var buf bytes.Buffer
+	if err := e.set.html.Execute(&buf, data); err != nil {
+		e.log.WithError(err).WithField("template", e.set.html.Name()).Warn("Error executing template, using the built-in rendering")
+
+		return description
+	}
+
+	return buf.String()
+}
+
+// execute renders the template, falling back to the built-in rendering if the template
+// is not set or fails.
+func (e *Event) execute(tmpl *texttemplate.Template, data *Data, fallback string) string {
+	if tmpl == nil {
+		return fallback
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		e.log.WithError(err).WithField("template", tmpl.Name()).Warn("Error executing template, using the built-in rendering")
+
+		return fallback
+	}
+
+	return buf.String()
+}
@@ -0,0 +1,150 @@
+package templates_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/split"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/templates"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name        string
+		configs     map[string]templates.Config
+		expectError bool
+	}{
+		{
+			name: "valid",
+			configs: map[string]templates.Config{
+				split.ControllerType: {Title: "{{ .Title }}", HTML: "<p>{{ .Description }}</p>"},
+			},
+		},
+		{
+			name:    "no templates",
+			configs: nil,
+		},
+		{
+			name: "invalid text template",
+			configs: map[string]templates.Config{
+				split.ControllerType: {Markdown: "{{ .Title "},
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid html template",
+			configs: map[string]templates.Config{
+				split.ControllerType: {HTML: "{{ if }}"},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := templates.New(logrus.New(), tt.configs)
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tmpl, err := templates.New(logrus.New(), map[string]templates.Config{
+		split.ControllerType: {
+			Title:    "{{ upper .Severity }}: controller of {{ .Group }} changed",
+			Markdown: "{{ .Description }}\n[Runbook](https://runbooks.example.com/{{ .Type }}) <@oncall>",
+			HTML:     `{{ .Description }}<p>Actual: {{ .Event.ActualController }} <a href="https://example.com/?q={{ .Subject }}">link</a></p>`,
+			Text:     "{{ .Event.MissingField }}",
+		},
+		templates.DefaultKey: {
+			Title: "Splitoor: {{ .Title }}",
+		},
+	})
+	require.NoError(t, err)
+
+	inner := split.NewController(now, "monitor", "group-1", "0x123", "0xaaa", "0xbbb")
+	e := tmpl.Apply(inner)
+
+	// wrapped events keep their identity
+	assert.Equal(t, inner, event.Unwrap(e))
+	assert.Equal(t, split.ControllerType, e.GetType())
+	assert.Equal(t, split.ControllerType, event.GetAlertType(e))
+	assert.Equal(t, "0x123", event.GetSubject(e))
+	assert.Equal(t, "group-1", e.GetGroup())
+
+	assert.Equal(t, "CRITICAL: controller of group-1 changed", e.GetTitle(true, true))
+
+	// the title template replaces the emoji prefix of the sources
+	assert.Empty(t, event.Emoji(e))
+	assert.Equal(t, "🚨", event.Emoji(inner))
+	assert.Equal(t, inner.GetDescriptionMarkdown(true, false)+"\n[Runbook](https://runbooks.example.com/split_controller) <@oncall>", e.GetDescriptionMarkdown(true, false))
+	assert.Equal(t, inner.GetDescriptionHTML(true, true)+`<p>Actual: 0xbbb <a href="https://example.com/?q=0x123">link</a></p>`, e.GetDescriptionHTML(true, true))
+
+	// failing templates fall back to the built-in rendering
+	assert.Equal(t, inner.GetDescriptionText(true, true), e.GetDescriptionText(true, true))
+
+	data, err := json.Marshal(e)
+	require.NoError(t, err)
+
+	expected, err := json.Marshal(inner)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(expected), string(data))
+
+	// resolved events have their own type, so they use the default templates
+	resolved := tmpl.Apply(event.NewResolved(now, now, "monitor", "group-1", split.ControllerType, split.ControllerSeverity, "Split controller has changed", "0x123"))
+	assert.True(t, event.IsResolved(resolved))
+	assert.Equal(t, "Splitoor: Resolved: Split controller has changed", resolved.GetTitle(false, false))
+
+	withEmoji, err := templates.New(logrus.New(), map[string]templates.Config{
+		templates.DefaultKey: {Title: "{{ .Emoji }} Splitoor: {{ .Title }}"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "✅ Splitoor: Resolved: Split controller has changed", withEmoji.Apply(event.Unwrap(resolved)).GetTitle(false, false))
+}
+
+func TestApplyDescriptionOnly(t *testing.T) {
+	inner := split.NewController(time.Now(), "monitor", "group-1", "0x123", "0xaaa", "0xbbb")
+
+	tmpl, err := templates.New(logrus.New(), map[string]templates.Config{
+		split.ControllerType:       {Markdown: "x"},
+		split.HashUnknownStateType: {Title: "{{ .Event.MissingField }}"},
+	})
+	require.NoError(t, err)
+
+	// the sources keep prefixing the emoji of the built-in title
+	e := tmpl.Apply(inner)
+	assert.Equal(t, "Split controller has changed", e.GetTitle(false, false))
+	assert.Equal(t, "🚨", event.Emoji(e))
+	assert.Equal(t, "x", e.GetDescriptionMarkdown(false, false))
+
+	// a failing title template falls back to the built-in title with its emoji
+	unknown := tmpl.Apply(split.NewHashUnknownState(time.Now(), "monitor", "group-1", "0x123", "expected", "actual"))
+	assert.Empty(t, event.Emoji(unknown))
+	assert.Equal(t, "🚨 "+event.Unwrap(unknown).GetTitle(false, false), unknown.GetTitle(false, false))
+}
+
+func TestApplyWithoutTemplates(t *testing.T) {
+	inner := split.NewController(time.Now(), "monitor", "group-1", "0x123", "0xaaa", "0xbbb")
+
+	tmpl, err := templates.New(logrus.New(), map[string]templates.Config{
+		split.HashUnknownStateType: {Title: "unknown"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, event.Event(inner), tmpl.Apply(inner))
+
+	var none *templates.Templates
+	assert.Equal(t, event.Event(inner), none.Apply(inner))
+}
@@ -25,6 +25,8 @@ type Client interface {
 	CheckSigners(ctx context.Context, safeAddress string) (bool, error)
 	// SetChainID sets the chain ID for the client
 	SetChainID(chainID string)
+	// Reachable returns whether the last request reached the API and when it was made
+	Reachable() (reachable bool, checkedAt time.Time)
 }
 
 type client struct {
@@ -34,8 +36,10 @@ type client struct {
 	client  *http.Client
 	metrics *Metrics
 
-	chainID string
-	mu      sync.Mutex
+	chainID   string
+	reachable bool
+	checkedAt time.Time
+	mu        sync.Mutex
 }
 
 // NewClient creates a new Safe API client
@@ -56,6 +60,21 @@ func (c *client) SetChainID(chainID string) {
 	c.chainID = chainID
 }
 
+func (c *client) Reachable() (reachable bool, checkedAt time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.reachable, c.checkedAt
+}
+
+func (c *client) setReachable(resp *http.Response, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.reachable = err == nil && resp.StatusCode < http.StatusInternalServerError
+	c.checkedAt = time.Now()
+}
+
 func (c *client) GetQueuedTransactions(ctx context.Context, safeAddress string) (*QueuedTransactionsResponse, error) {
 	c.mu.Lock()
 
@@ -81,6 +100,8 @@ func (c *client) GetQueuedTransactions(ctx context.Context, safeAddress string)
 	}
 
 	resp, err := c.client.Do(req)
+	c.setReachable(resp, err)
+
 	if err != nil {
 		c.metrics.ObserveResponse("GET", c.baseURL, path, "error", cid, safeAddress, time.Since(start))
 
@@ -127,6 +148,8 @@ func (c *client) GetTransaction(ctx context.Context, safeTxHash string) (*Transa
 	}
 
 	resp, err := c.client.Do(req)
+	c.setReachable(resp, err)
+
 	if err != nil {
 		c.metrics.ObserveResponse("GET", c.baseURL, path, "error", cid, safeTxHash, time.Since(start))
 
@@ -173,6 +196,8 @@ func (c *client) GetSafe(ctx context.Context, safeAddress string) (*SafeResponse
 	}
 
 	resp, err := c.client.Do(req)
+	c.setReachable(resp, err)
+
 	if err != nil {
 		c.metrics.ObserveResponse("GET", c.baseURL, path, "error", cid, safeAddress, time.Since(start))
 
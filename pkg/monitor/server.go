@@ -2,6 +2,7 @@ package monitor
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"os/signal"
 	"syscall"
@@ -11,10 +12,15 @@ import (
 	_ "net/http/pprof"
 
 	"github.com/ethpandaops/splitoor/pkg/ethereum"
+	"github.com/ethpandaops/splitoor/pkg/monitor/api"
 	"github.com/ethpandaops/splitoor/pkg/monitor/beaconchain"
+	"github.com/ethpandaops/splitoor/pkg/monitor/digest"
+	"github.com/ethpandaops/splitoor/pkg/monitor/health"
 	"github.com/ethpandaops/splitoor/pkg/monitor/notifier"
 	"github.com/ethpandaops/splitoor/pkg/monitor/safe"
 	"github.com/ethpandaops/splitoor/pkg/monitor/service"
+	"github.com/ethpandaops/splitoor/pkg/monitor/status"
+	"github.com/ethpandaops/splitoor/pkg/monitor/store"
 	"github.com/ethpandaops/splitoor/pkg/observability"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sync/errgroup"
@@ -26,15 +32,19 @@ type Server struct {
 
 	services  []service.Service
 	publisher *notifier.Publisher
+	digests   []*digest.Digest
 
 	metricsServer *http.Server
 	pprofServer   *http.Server
 	healthServer  *http.Server
+	apiServer     *http.Server
 
 	ethereumPool *ethereum.Pool
 
 	beaconchainClient beaconchain.Client
 	safeClient        safe.Client
+
+	store store.Store
 }
 
 func NewServer(ctx context.Context, log logrus.FieldLogger, conf *Config) (*Server, error) {
@@ -44,12 +54,11 @@ func NewServer(ctx context.Context, log logrus.FieldLogger, conf *Config) (*Serv
 
 	ethereumPool := ethereum.NewPool(ctx, log, conf.Name, &conf.Ethereum)
 
-	publisher, err := notifier.NewPublisher(ctx, log, conf.Name, conf.Notifier)
-	if err != nil {
-		return nil, err
-	}
+	var (
+		beaconchainClient beaconchain.Client
+		err               error
+	)
 
-	var beaconchainClient beaconchain.Client
 	if conf.Beaconchain.Enabled {
 		beaconchainClient, err = beaconchain.NewClient(ctx, log, conf.Name, &conf.Beaconchain)
 		if err != nil {
@@ -65,12 +74,30 @@ func NewServer(ctx context.Context, log logrus.FieldLogger, conf *Config) (*Serv
 		}
 	}
 
-	services, err := service.CreateServices(ctx, log, conf.Name, &conf.Services, ethereumPool, publisher, beaconchainClient, safeClient)
+	st, err := store.NewStore(ctx, log, &conf.Store)
 	if err != nil {
 		return nil, err
 	}
 
-	return &Server{
+	publisher, err := notifier.NewPublisher(ctx, log, conf.Name, conf.Notifier, st)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, d := range conf.Digests {
+		for _, name := range d.Sources {
+			if !publisher.HasSource(name) {
+				return nil, fmt.Errorf("digest %s source %s does not exist", d.GetName(), name)
+			}
+		}
+	}
+
+	services, err := service.CreateServices(ctx, log, conf.Name, &conf.Services, ethereumPool, publisher, beaconchainClient, safeClient, st)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
 		config:            conf,
 		log:               log.WithField("component", "server"),
 		services:          services,
@@ -78,7 +105,16 @@ func NewServer(ctx context.Context, log logrus.FieldLogger, conf *Config) (*Serv
 		ethereumPool:      ethereumPool,
 		beaconchainClient: beaconchainClient,
 		safeClient:        safeClient,
-	}, nil
+		store:             st,
+	}
+
+	splits, validators := s.statusProviders()
+
+	for i := range conf.Digests {
+		s.digests = append(s.digests, digest.NewDigest(log, conf.Name, &conf.Digests[i], publisher, splits, validators, st))
+	}
+
+	return s, nil
 }
 
 func (s *Server) Start(ctx context.Context) error {
@@ -87,6 +123,15 @@ func (s *Server) Start(ctx context.Context) error {
 
 	observability.StartMetricsServer(ctx, s.config.MetricsAddr)
 
+	if err := s.store.Start(ctx); err != nil {
+		return err
+	}
+
+	// the journal and silences are loaded before anything can publish or change them
+	if err := s.publisher.Start(ctx); err != nil {
+		return err
+	}
+
 	g, ctx := errgroup.WithContext(ctx)
 
 	if s.config.PProfAddr != nil {
@@ -113,12 +158,24 @@ func (s *Server) Start(ctx context.Context) error {
 		})
 	}
 
+	if s.config.APIAddr != nil {
+		g.Go(func() error {
+			if err := s.startAPI(); err != nil {
+				if err != http.ErrServerClosed {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}
+
 	g.Go(func() error {
-		return s.publisher.Start(ctx)
+		return s.startServices(ctx)
 	})
 
 	g.Go(func() error {
-		return s.startServices(ctx)
+		return s.startDigests(ctx)
 	})
 
 	g.Go(func() error {
@@ -141,16 +198,27 @@ func (s *Server) Start(ctx context.Context) error {
 }
 
 func (s *Server) stop(ctx context.Context) error {
-	if err := s.publisher.Stop(ctx); err != nil {
-		return err
-	}
-
 	for _, svc := range s.services {
 		if err := svc.Stop(ctx); err != nil {
 			return err
 		}
 	}
 
+	for _, d := range s.digests {
+		if err := d.Stop(ctx); err != nil {
+			return err
+		}
+	}
+
+	// stopped last so the events published while stopping the services are still delivered
+	if err := s.publisher.Stop(ctx); err != nil {
+		return err
+	}
+
+	if err := s.store.Stop(ctx); err != nil {
+		return err
+	}
+
 	if s.pprofServer != nil {
 		if err := s.pprofServer.Shutdown(ctx); err != nil {
 			return err
@@ -163,6 +231,12 @@ func (s *Server) stop(ctx context.Context) error {
 		}
 	}
 
+	if s.apiServer != nil {
+		if err := s.apiServer.Shutdown(ctx); err != nil {
+			return err
+		}
+	}
+
 	if s.metricsServer != nil {
 		if err := s.metricsServer.Shutdown(ctx); err != nil {
 			return err
@@ -184,6 +258,16 @@ func (s *Server) startServices(ctx context.Context) error {
 	return nil
 }
 
+func (s *Server) startDigests(ctx context.Context) error {
+	for _, d := range s.digests {
+		if err := d.Start(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (s *Server) startPProf() error {
 	s.log.WithField("addr", *s.config.PProfAddr).Info("Starting pprof server")
 
@@ -201,11 +285,69 @@ func (s *Server) startHealthCheck() error {
 	s.healthServer = &http.Server{
 		Addr:              *s.config.HealthCheckAddr,
 		ReadHeaderTimeout: 120 * time.Second,
+		Handler:           s.healthChecker().Handler(),
 	}
 
-	s.healthServer.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-	})
-
 	return s.healthServer.ListenAndServe()
 }
+
+func (s *Server) healthChecker() *health.Checker {
+	checker := health.NewChecker(s.log)
+	splits, validators := s.statusProviders()
+	startedAt := time.Now()
+
+	checker.AddLivenessCheck(health.TickCheck(splits, validators, s.config.Health.MaxTickAge, startedAt, false))
+
+	checker.AddReadinessCheck(health.BoolCheck("execution", "no healthy execution nodes", s.ethereumPool.HasHealthyExecutionNodes))
+
+	if len(validators) > 0 && s.ethereumPool.HasBeaconNodes() {
+		checker.AddReadinessCheck(health.BoolCheck("beacon", "no healthy beacon nodes", s.ethereumPool.HasHealthyBeaconNodes))
+	}
+
+	checker.AddReadinessCheck(health.TickCheck(splits, validators, s.config.Health.MaxTickAge, startedAt, true))
+
+	if s.safeClient != nil {
+		checker.AddReadinessCheck(health.ReachableCheck("safe", s.safeClient.Reachable))
+	}
+
+	if s.beaconchainClient != nil {
+		checker.AddReadinessCheck(health.ReachableCheck("beaconchain", s.beaconchainClient.Reachable))
+	}
+
+	checker.AddReadinessCheck(health.BoolCheck("notifier", "notifier sources not started", s.publisher.Started))
+
+	return checker
+}
+
+func (s *Server) startAPI() error {
+	s.log.WithField("addr", *s.config.APIAddr).Info("Starting api server")
+
+	splits, validators := s.statusProviders()
+
+	token := ""
+	if s.config.APIToken != nil {
+		token = *s.config.APIToken
+	}
+
+	s.apiServer = &http.Server{
+		Addr:              *s.config.APIAddr,
+		ReadHeaderTimeout: 120 * time.Second,
+		Handler:           api.NewHandler(s.log, splits, validators, s.publisher.Silences(), s.publisher.Journal(), token),
+	}
+
+	return s.apiServer.ListenAndServe()
+}
+
+func (s *Server) statusProviders() (splits []status.SplitProvider, validators []status.ValidatorProvider) {
+	for _, svc := range s.services {
+		if sp, ok := svc.(status.SplitProvider); ok {
+			splits = append(splits, sp)
+		}
+
+		if vp, ok := svc.(status.ValidatorProvider); ok {
+			validators = append(validators, vp)
+		}
+	}
+
+	return splits, validators
+}
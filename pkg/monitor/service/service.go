@@ -9,6 +9,7 @@ import (
 	"github.com/ethpandaops/splitoor/pkg/monitor/safe"
 	"github.com/ethpandaops/splitoor/pkg/monitor/service/split"
 	"github.com/ethpandaops/splitoor/pkg/monitor/service/validator"
+	"github.com/ethpandaops/splitoor/pkg/monitor/store"
 	"github.com/sirupsen/logrus"
 )
 
@@ -25,11 +26,11 @@ const (
 	ServiceTypeValidator Type = validator.ServiceType
 )
 
-func CreateServices(ctx context.Context, log logrus.FieldLogger, monitor string, cfg *Config, ethereumPool *ethereum.Pool, publisher *notifier.Publisher, beaconchainClient beaconchain.Client, safeClient safe.Client) ([]Service, error) {
+func CreateServices(ctx context.Context, log logrus.FieldLogger, monitor string, cfg *Config, ethereumPool *ethereum.Pool, publisher *notifier.Publisher, beaconchainClient beaconchain.Client, safeClient safe.Client, st store.Store) ([]Service, error) {
 	services := []Service{}
 
 	if cfg.Split != nil {
-		sp, err := split.NewService(ctx, log, monitor, cfg.Split, ethereumPool, publisher, safeClient)
+		sp, err := split.NewService(ctx, log, monitor, cfg.Split, ethereumPool, publisher, safeClient, st)
 		if err != nil {
 			return nil, err
 		}
@@ -38,7 +39,7 @@ func CreateServices(ctx context.Context, log logrus.FieldLogger, monitor string,
 	}
 
 	if cfg.Validator != nil {
-		vp, err := validator.NewService(ctx, log, monitor, cfg.Validator, ethereumPool, publisher, beaconchainClient)
+		vp, err := validator.NewService(ctx, log, monitor, cfg.Validator, ethereumPool, publisher, beaconchainClient, st)
 		if err != nil {
 			return nil, err
 		}
@@ -2,11 +2,13 @@ package account
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/0xsequence/ethkit/ethcoder"
 	spl "github.com/ethpandaops/splitoor/pkg/0xsplits/split"
 	"github.com/ethpandaops/splitoor/pkg/ethereum"
+	"github.com/ethpandaops/splitoor/pkg/monitor/status"
 	"github.com/sirupsen/logrus"
 )
 
@@ -24,6 +26,9 @@ type Account struct {
 	contract *ethcoder.ABI
 
 	metrics *Metrics
+
+	sources map[string]*status.AccountSource
+	mu      sync.Mutex
 }
 
 func NewAccount(log logrus.FieldLogger, monitor, name, address string, allocation uint32, shouldGatherMetrics bool, ethereumPool *ethereum.Pool) *Account {
@@ -36,6 +41,7 @@ func NewAccount(log logrus.FieldLogger, monitor, name, address string, allocatio
 		shouldGatherMetrics: shouldGatherMetrics,
 		ethereumPool:        ethereumPool,
 		metrics:             GetMetricsInstance("splitoor_split_account", monitor),
+		sources:             make(map[string]*status.AccountSource),
 	}
 }
 
@@ -80,6 +86,39 @@ func (a *Account) Allocation() uint32 {
 	return a.allocation
 }
 
+func (a *Account) Status() *status.SplitAccount {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	sources := make(map[string]*status.AccountSource, len(a.sources))
+
+	for source, s := range a.sources {
+		src := *s
+		sources[source] = &src
+	}
+
+	return &status.SplitAccount{
+		Address:    a.address,
+		Allocation: a.allocation,
+		Sources:    sources,
+	}
+}
+
+func (a *Account) updateSource(source string, update func(s *status.AccountSource)) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	s, exists := a.sources[source]
+	if !exists {
+		s = &status.AccountSource{}
+		a.sources[source] = s
+	}
+
+	update(s)
+
+	s.UpdatedAt = time.Now()
+}
+
 func (a *Account) tick(ctx context.Context) {
 	for _, node := range a.ethereumPool.GetHealthyExecutionNodes() {
 		balance, err := node.BalanceAt(ctx, a.address)
@@ -95,6 +134,12 @@ func (a *Account) tick(ctx context.Context) {
 
 		a.metrics.UpdateBalance(float64(balance.Uint64()), []string{a.name, node.Name(), a.address})
 
+		balanceString := balance.String()
+
+		a.updateSource(node.Name(), func(s *status.AccountSource) {
+			s.Balance = balanceString
+		})
+
 		if a.client != nil && a.contract != nil {
 			balance, err := a.client.GetETHBalance(ctx, node, a.contract, a.address)
 			if err != nil {
@@ -108,6 +153,12 @@ func (a *Account) tick(ctx context.Context) {
 			}
 
 			a.metrics.UpdateSplitBalance(float64(balance.Uint64()), []string{a.name, node.Name(), a.address})
+
+			splitBalanceString := balance.String()
+
+			a.updateSource(node.Name(), func(s *status.AccountSource) {
+				s.SplitBalance = splitBalanceString
+			})
 		}
 	}
 }
@@ -3,14 +3,17 @@ package alert
 import (
 	"sync"
 
+	event "github.com/ethpandaops/splitoor/pkg/monitor/event/split"
+	"github.com/ethpandaops/splitoor/pkg/monitor/store"
 	"github.com/sirupsen/logrus"
 )
 
 type Controller struct {
+	store.AlertTracker
+
 	log                logrus.FieldLogger
 	expectedController string
 
-	alerting   bool
 	controller string
 	mu         sync.Mutex
 }
@@ -22,26 +25,21 @@ func NewController(log logrus.FieldLogger, expectedController string) *Controlle
 	}
 }
 
-func (b *Controller) Update(controller string) (shouldAlert bool) {
+func (b *Controller) Update(controller string) (shouldAlert, shouldResolve bool) {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
-	shouldBeAlerting := controller != b.expectedController
+	// an immutable split has its own alert, a mismatch that is already alerting keeps firing
+	// as the split can't be moved back to the expected controller anymore
+	if controller == event.ZeroAddress {
+		b.controller = controller
 
-	if b.alerting {
-		shouldAlert = false
+		return false, false
+	}
 
-		if !shouldBeAlerting {
-			b.alerting = false
-		}
-	} else {
-		shouldAlert = false
+	shouldBeAlerting := controller != b.expectedController
 
-		if shouldBeAlerting {
-			b.alerting = true
-			shouldAlert = true
-		}
-	}
+	shouldAlert, shouldResolve = b.Set(shouldBeAlerting)
 
 	b.controller = controller
 
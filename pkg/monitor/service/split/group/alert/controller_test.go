@@ -0,0 +1,50 @@
+package alert
+
+import (
+	"testing"
+
+	event "github.com/ethpandaops/splitoor/pkg/monitor/event/split"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestControllerUpdate(t *testing.T) {
+	tests := []struct {
+		name        string
+		controllers []string
+		wantAlert   []bool
+		wantResolve []bool
+	}{
+		{
+			name:        "mismatch resolved",
+			controllers: []string{"0xexpected", "0xother", "0xexpected"},
+			wantAlert:   []bool{false, true, false},
+			wantResolve: []bool{false, false, true},
+		},
+		{
+			name:        "mismatch keeps firing when the split becomes immutable",
+			controllers: []string{"0xother", event.ZeroAddress, event.ZeroAddress},
+			wantAlert:   []bool{true, false, false},
+			wantResolve: []bool{false, false, false},
+		},
+		{
+			name:        "immutable split does not alert",
+			controllers: []string{"0xexpected", event.ZeroAddress},
+			wantAlert:   []bool{false, false},
+			wantResolve: []bool{false, false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := NewController(logrus.New(), "0xexpected")
+
+			for i, controller := range tt.controllers {
+				shouldAlert, shouldResolve := a.Update(controller)
+
+				assert.Equal(t, tt.wantAlert[i], shouldAlert, "update %d", i)
+				assert.Equal(t, tt.wantResolve[i], shouldResolve, "update %d", i)
+			}
+		})
+	}
+}
@@ -3,16 +3,18 @@ package alert
 import (
 	"sync"
 
+	"github.com/ethpandaops/splitoor/pkg/monitor/store"
 	"github.com/sirupsen/logrus"
 )
 
 type HashInitial struct {
+	store.AlertTracker
+
 	log         logrus.FieldLogger
 	initialHash string
 
-	alerting bool
-	hash     string
-	mu       sync.Mutex
+	hash string
+	mu   sync.Mutex
 }
 
 func NewHashInitial(log logrus.FieldLogger, initialHash string) *HashInitial {
@@ -22,26 +24,13 @@ func NewHashInitial(log logrus.FieldLogger, initialHash string) *HashInitial {
 	}
 }
 
-func (b *HashInitial) Update(hash string) (shouldAlert bool) {
+func (b *HashInitial) Update(hash string) (shouldAlert, shouldResolve bool) {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
 	shouldBeAlerting := hash == b.initialHash
 
-	if b.alerting {
-		shouldAlert = false
-
-		if !shouldBeAlerting {
-			b.alerting = false
-		}
-	} else {
-		shouldAlert = false
-
-		if shouldBeAlerting {
-			b.alerting = true
-			shouldAlert = true
-		}
-	}
+	shouldAlert, shouldResolve = b.Set(shouldBeAlerting)
 
 	b.hash = hash
 
@@ -3,16 +3,18 @@ package alert
 import (
 	"sync"
 
+	"github.com/ethpandaops/splitoor/pkg/monitor/store"
 	"github.com/sirupsen/logrus"
 )
 
 type HashRecovery struct {
+	store.AlertTracker
+
 	log          logrus.FieldLogger
 	recoveryHash string
 
-	alerting bool
-	hash     string
-	mu       sync.Mutex
+	hash string
+	mu   sync.Mutex
 }
 
 func NewHashRecovery(log logrus.FieldLogger, recoveryHash string) *HashRecovery {
@@ -22,26 +24,13 @@ func NewHashRecovery(log logrus.FieldLogger, recoveryHash string) *HashRecovery
 	}
 }
 
-func (b *HashRecovery) Update(hash string) (shouldAlert bool) {
+func (b *HashRecovery) Update(hash string) (shouldAlert, shouldResolve bool) {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
 	shouldBeAlerting := hash == b.recoveryHash
 
-	if b.alerting {
-		shouldAlert = false
-
-		if !shouldBeAlerting {
-			b.alerting = false
-		}
-	} else {
-		shouldAlert = false
-
-		if shouldBeAlerting {
-			b.alerting = true
-			shouldAlert = true
-		}
-	}
+	shouldAlert, shouldResolve = b.Set(shouldBeAlerting)
 
 	b.hash = hash
 
@@ -4,16 +4,18 @@ import (
 	"slices"
 	"sync"
 
+	"github.com/ethpandaops/splitoor/pkg/monitor/store"
 	"github.com/sirupsen/logrus"
 )
 
 type HashUnknown struct {
+	store.AlertTracker
+
 	log            logrus.FieldLogger
 	expectedHashes []string
 
-	alerting bool
-	hash     string
-	mu       sync.Mutex
+	hash string
+	mu   sync.Mutex
 }
 
 func NewHashUnknown(log logrus.FieldLogger, expectedHashes []string) *HashUnknown {
@@ -23,26 +25,13 @@ func NewHashUnknown(log logrus.FieldLogger, expectedHashes []string) *HashUnknow
 	}
 }
 
-func (b *HashUnknown) Update(hash string) (shouldAlert bool) {
+func (b *HashUnknown) Update(hash string) (shouldAlert, shouldResolve bool) {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
 	shouldBeAlerting := !slices.Contains(b.expectedHashes, hash)
 
-	if b.alerting {
-		shouldAlert = false
-
-		if !shouldBeAlerting {
-			b.alerting = false
-		}
-	} else {
-		shouldAlert = false
-
-		if shouldBeAlerting {
-			b.alerting = true
-			shouldAlert = true
-		}
-	}
+	shouldAlert, shouldResolve = b.Set(shouldBeAlerting)
 
 	b.hash = hash
 
@@ -0,0 +1,24 @@
+package alert
+
+import (
+	event "github.com/ethpandaops/splitoor/pkg/monitor/event/split"
+	"github.com/ethpandaops/splitoor/pkg/monitor/store"
+	"github.com/sirupsen/logrus"
+)
+
+// Immutable alerts while the controller of the split is the zero address.
+type Immutable struct {
+	store.AlertTracker
+
+	log logrus.FieldLogger
+}
+
+func NewImmutable(log logrus.FieldLogger) *Immutable {
+	return &Immutable{
+		log: log,
+	}
+}
+
+func (b *Immutable) Update(controller string) (shouldAlert, shouldResolve bool) {
+	return b.Set(controller == event.ZeroAddress)
+}
@@ -0,0 +1,44 @@
+package alert
+
+import (
+	"strings"
+	"sync"
+
+	event "github.com/ethpandaops/splitoor/pkg/monitor/event/split"
+	"github.com/ethpandaops/splitoor/pkg/monitor/store"
+	"github.com/sirupsen/logrus"
+)
+
+// PendingControlTransfer alerts while a control transfer of the split to an address other
+// than the expected controller is pending.
+type PendingControlTransfer struct {
+	store.AlertTracker
+
+	log                logrus.FieldLogger
+	expectedController string
+
+	potentialController string
+	mu                  sync.Mutex
+}
+
+func NewPendingControlTransfer(log logrus.FieldLogger, expectedController string) *PendingControlTransfer {
+	return &PendingControlTransfer{
+		log:                log,
+		expectedController: expectedController,
+	}
+}
+
+// Update takes the new potential controller of the split, the zero address if no control
+// transfer is pending.
+func (b *PendingControlTransfer) Update(potentialController string) (shouldAlert, shouldResolve bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	shouldBeAlerting := potentialController != event.ZeroAddress && !strings.EqualFold(potentialController, b.expectedController)
+
+	shouldAlert, shouldResolve = b.Set(shouldBeAlerting)
+
+	b.potentialController = potentialController
+
+	return
+}
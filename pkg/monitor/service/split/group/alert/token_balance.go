@@ -0,0 +1,45 @@
+package alert
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/store"
+	"github.com/sirupsen/logrus"
+)
+
+// TokenBalance alerts while the token balance of the split is below the minimum or above
+// the maximum balance.
+type TokenBalance struct {
+	store.AlertTracker
+
+	log logrus.FieldLogger
+	// above alerts above the limit instead of below
+	above bool
+	limit *big.Rat
+
+	mu sync.Mutex
+}
+
+func NewTokenBalance(log logrus.FieldLogger, limit *big.Rat, above bool) *TokenBalance {
+	return &TokenBalance{
+		log:   log,
+		above: above,
+		limit: limit,
+	}
+}
+
+// Update takes the token balance of the split in whole tokens.
+func (b *TokenBalance) Update(balance *big.Rat) (shouldAlert, shouldResolve bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	shouldBeAlerting := balance.Cmp(b.limit) < 0
+	if b.above {
+		shouldBeAlerting = balance.Cmp(b.limit) > 0
+	}
+
+	shouldAlert, shouldResolve = b.Set(shouldBeAlerting)
+
+	return
+}
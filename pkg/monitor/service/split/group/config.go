@@ -2,9 +2,11 @@ package group
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/ethpandaops/splitoor/pkg/monitor/service/split/group/account"
 	"github.com/ethpandaops/splitoor/pkg/monitor/service/split/group/controller"
+	"github.com/ethpandaops/splitoor/pkg/monitor/service/split/group/token"
 )
 
 type Config struct {
@@ -14,6 +16,60 @@ type Config struct {
 	Contract        *string           `yaml:"contract"`
 	Accounts        []*account.Config `yaml:"accounts"`
 	Controller      controller.Config `yaml:"controller"`
+	// Tokens are the ERC-20 tokens whose balances of the split and its accounts are monitored.
+	Tokens []*token.Config `yaml:"tokens"`
+	// Logs configures scanning the SplitMain logs of the split.
+	Logs LogsConfig `yaml:"logs"`
+}
+
+type LogsConfig struct {
+	// Enabled toggles publishing an event for every SplitMain log of the split, defaults to false.
+	Enabled bool `yaml:"enabled"`
+	// StartBlock is the block scanning starts from when there is no stored progress, defaults to the
+	// last confirmed block.
+	StartBlock *uint64 `yaml:"startBlock"`
+	// MaxBlockRange is the maximum number of blocks requested per eth_getLogs call, defaults to 1000.
+	MaxBlockRange uint64 `yaml:"maxBlockRange"`
+	// RecipientsLookback is the maximum number of blocks searched back for the transaction that set
+	// the recipients when the split hash is unknown, defaults to 100000.
+	RecipientsLookback uint64 `yaml:"recipientsLookback"`
+	// Confirmations is the number of blocks a log must be behind the head before it is published, so
+	// logs of blocks that are reorged out are not published, defaults to 12.
+	Confirmations *uint64 `yaml:"confirmations"`
+}
+
+const (
+	DefaultLogsMaxBlockRange      uint64 = 1000
+	DefaultLogsRecipientsLookback uint64 = 100000
+	DefaultLogsConfirmations      uint64 = 12
+)
+
+func (c *LogsConfig) IsEnabled() bool {
+	return c.Enabled
+}
+
+func (c *LogsConfig) GetMaxBlockRange() uint64 {
+	if c.MaxBlockRange == 0 {
+		return DefaultLogsMaxBlockRange
+	}
+
+	return c.MaxBlockRange
+}
+
+func (c *LogsConfig) GetRecipientsLookback() uint64 {
+	if c.RecipientsLookback == 0 {
+		return DefaultLogsRecipientsLookback
+	}
+
+	return c.RecipientsLookback
+}
+
+func (c *LogsConfig) GetConfirmations() uint64 {
+	if c.Confirmations == nil {
+		return DefaultLogsConfirmations
+	}
+
+	return *c.Confirmations
 }
 
 func (c *Config) Validate() error {
@@ -51,5 +107,20 @@ func (c *Config) Validate() error {
 		return err
 	}
 
+	tokens := make(map[string]bool, len(c.Tokens))
+
+	for _, t := range c.Tokens {
+		if err := t.Validate(); err != nil {
+			return err
+		}
+
+		address := strings.ToLower(t.Address)
+		if tokens[address] {
+			return fmt.Errorf("duplicate token %s", t.Address)
+		}
+
+		tokens[address] = true
+	}
+
 	return nil
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ethpandaops/splitoor/pkg/monitor/service/split/group/account"
 	"github.com/ethpandaops/splitoor/pkg/monitor/service/split/group/controller"
+	"github.com/ethpandaops/splitoor/pkg/monitor/service/split/group/token"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -38,6 +39,65 @@ func TestConfigValidate(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "valid config - tokens",
+			config: &Config{
+				Name:            "test_group",
+				Address:         "0x123",
+				RecoveryAddress: "0x789",
+				Accounts: []*account.Config{
+					{Name: "account1", Address: "0x456", Allocation: 999999},
+					{Name: "account2", Address: "0x123", Allocation: 1},
+				},
+				Controller: controller.Config{
+					ControllerType: controller.ControllerTypeEOA,
+				},
+				Tokens: []*token.Config{
+					{Address: "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48", MinBalance: "1"},
+					{Address: "0xdAC17F958D2ee523a2206206994597C13D831ec7", MaxBalance: "1000"},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid config - duplicate tokens",
+			config: &Config{
+				Name:            "test_group",
+				Address:         "0x123",
+				RecoveryAddress: "0x789",
+				Accounts: []*account.Config{
+					{Name: "account1", Address: "0x456", Allocation: 999999},
+					{Name: "account2", Address: "0x123", Allocation: 1},
+				},
+				Controller: controller.Config{
+					ControllerType: controller.ControllerTypeEOA,
+				},
+				Tokens: []*token.Config{
+					{Address: "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"},
+					{Address: "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid config - invalid token",
+			config: &Config{
+				Name:            "test_group",
+				Address:         "0x123",
+				RecoveryAddress: "0x789",
+				Accounts: []*account.Config{
+					{Name: "account1", Address: "0x456", Allocation: 999999},
+					{Name: "account2", Address: "0x123", Allocation: 1},
+				},
+				Controller: controller.Config{
+					ControllerType: controller.ControllerTypeEOA,
+				},
+				Tokens: []*token.Config{
+					{Address: "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48", MinBalance: "10", MaxBalance: "1"},
+				},
+			},
+			expectError: true,
+		},
 		{
 			name: "invalid config - one account",
 			config: &Config{
@@ -224,3 +284,42 @@ func TestConfigValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestLogsConfig(t *testing.T) {
+	confirmations := uint64(0)
+
+	tests := []struct {
+		name                   string
+		config                 LogsConfig
+		wantEnabled            bool
+		wantMaxBlockRange      uint64
+		wantRecipientsLookback uint64
+		wantConfirmations      uint64
+	}{
+		{
+			name:                   "defaults",
+			config:                 LogsConfig{},
+			wantEnabled:            false,
+			wantMaxBlockRange:      DefaultLogsMaxBlockRange,
+			wantRecipientsLookback: DefaultLogsRecipientsLookback,
+			wantConfirmations:      DefaultLogsConfirmations,
+		},
+		{
+			name:                   "configured",
+			config:                 LogsConfig{Enabled: true, MaxBlockRange: 100, RecipientsLookback: 5000, Confirmations: &confirmations},
+			wantEnabled:            true,
+			wantMaxBlockRange:      100,
+			wantRecipientsLookback: 5000,
+			wantConfirmations:      0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantEnabled, tt.config.IsEnabled())
+			assert.Equal(t, tt.wantMaxBlockRange, tt.config.GetMaxBlockRange())
+			assert.Equal(t, tt.wantRecipientsLookback, tt.config.GetRecipientsLookback())
+			assert.Equal(t, tt.wantConfirmations, tt.config.GetConfirmations())
+		})
+	}
+}
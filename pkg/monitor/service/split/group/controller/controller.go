@@ -10,6 +10,8 @@ import (
 	s "github.com/ethpandaops/splitoor/pkg/monitor/safe"
 	"github.com/ethpandaops/splitoor/pkg/monitor/service/split/group/controller/eoa"
 	"github.com/ethpandaops/splitoor/pkg/monitor/service/split/group/controller/safe"
+	"github.com/ethpandaops/splitoor/pkg/monitor/status"
+	"github.com/ethpandaops/splitoor/pkg/monitor/store"
 
 	"github.com/sirupsen/logrus"
 )
@@ -20,9 +22,11 @@ type Controller interface {
 	Type() string
 	Name() string
 	Address() string
+	Status() *status.Controller
+	Alerts() []*status.Alert
 }
 
-func NewController(ctx context.Context, log logrus.FieldLogger, monitor, name string, controllerType ControllerType, config *RawMessage, splitAddress, recoveryAddress, splitsContractAddress string, ethereumPool *ethereum.Pool, safeClient s.Client, publisher *notifier.Publisher) (Controller, error) {
+func NewController(ctx context.Context, log logrus.FieldLogger, monitor, name string, controllerType ControllerType, config *RawMessage, splitAddress, recoveryAddress, splitsContractAddress string, ethereumPool *ethereum.Pool, safeClient s.Client, publisher *notifier.Publisher, st store.Store) (Controller, error) {
 	if controllerType == ControllerTypeUnknown {
 		return nil, errors.New("controller type is required")
 	}
@@ -55,7 +59,7 @@ func NewController(ctx context.Context, log logrus.FieldLogger, monitor, name st
 			return nil, err
 		}
 
-		return safe.New(ctx, log, monitor, name, conf, splitAddress, recoveryAddress, splitsContractAddress, ethereumPool, safeClient, publisher)
+		return safe.New(ctx, log, monitor, name, conf, splitAddress, recoveryAddress, splitsContractAddress, ethereumPool, safeClient, publisher, st)
 	}
 
 	return nil, errors.New("controller type is not supported")
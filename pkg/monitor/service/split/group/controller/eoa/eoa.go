@@ -3,6 +3,7 @@ package eoa
 import (
 	"context"
 
+	"github.com/ethpandaops/splitoor/pkg/monitor/status"
 	"github.com/sirupsen/logrus"
 )
 
@@ -41,3 +42,14 @@ func (c *EOA) Name() string {
 func (c *EOA) Address() string {
 	return c.address
 }
+
+func (c *EOA) Status() *status.Controller {
+	return &status.Controller{
+		Type:    ControllerType,
+		Address: c.address,
+	}
+}
+
+func (c *EOA) Alerts() []*status.Alert {
+	return []*status.Alert{}
+}
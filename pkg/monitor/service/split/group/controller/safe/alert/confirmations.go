@@ -3,13 +3,15 @@ package alert
 import (
 	"sync"
 
+	"github.com/ethpandaops/splitoor/pkg/monitor/store"
 	"github.com/sirupsen/logrus"
 )
 
 type Confirmations struct {
+	store.AlertTracker
+
 	log logrus.FieldLogger
 
-	alerting              bool
 	numConfirmations      int
 	expectedConfirmations int
 
@@ -22,27 +24,14 @@ func NewConfirmations(log logrus.FieldLogger) *Confirmations {
 	}
 }
 
-func (c *Confirmations) Update(numConfirmations, expectedConfirmations int, hasNextRecoveryTx bool) (shouldAlert bool) {
+func (c *Confirmations) Update(numConfirmations, expectedConfirmations int, hasNextRecoveryTx bool) (shouldAlert, shouldResolve bool) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
 	// only alert if the number of confirmations is not the expected number and there is a valid recovery tx that is next in the queue
 	shouldBeAlerting := numConfirmations != expectedConfirmations && hasNextRecoveryTx
 
-	if c.alerting {
-		shouldAlert = false
-
-		if !shouldBeAlerting {
-			c.alerting = false
-		}
-	} else {
-		shouldAlert = false
-
-		if shouldBeAlerting {
-			c.alerting = true
-			shouldAlert = true
-		}
-	}
+	shouldAlert, shouldResolve = c.Set(shouldBeAlerting)
 
 	c.numConfirmations = numConfirmations
 	c.expectedConfirmations = expectedConfirmations
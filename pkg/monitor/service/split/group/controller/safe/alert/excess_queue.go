@@ -3,15 +3,17 @@ package alert
 import (
 	"sync"
 
+	"github.com/ethpandaops/splitoor/pkg/monitor/store"
 	"github.com/sirupsen/logrus"
 )
 
 type ExcessQueue struct {
+	store.AlertTracker
+
 	log logrus.FieldLogger
 
-	alerting bool
-	length   int
-	maxLen   int
+	length int
+	maxLen int
 
 	mu sync.Mutex
 }
@@ -23,26 +25,13 @@ func NewExcessQueue(log logrus.FieldLogger, maxLen int) *ExcessQueue {
 	}
 }
 
-func (e *ExcessQueue) Update(length int) (shouldAlert bool) {
+func (e *ExcessQueue) Update(length int) (shouldAlert, shouldResolve bool) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 
 	shouldBeAlerting := length > e.maxLen
 
-	if e.alerting {
-		shouldAlert = false
-
-		if !shouldBeAlerting {
-			e.alerting = false
-		}
-	} else {
-		shouldAlert = false
-
-		if shouldBeAlerting {
-			e.alerting = true
-			shouldAlert = true
-		}
-	}
+	shouldAlert, shouldResolve = e.Set(shouldBeAlerting)
 
 	e.length = length
 
@@ -50,5 +39,5 @@ func (e *ExcessQueue) Update(length int) (shouldAlert bool) {
 }
 
 func (e *ExcessQueue) Alerting() bool {
-	return e.alerting
+	return e.State().Alerting
 }
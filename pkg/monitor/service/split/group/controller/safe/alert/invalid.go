@@ -3,14 +3,16 @@ package alert
 import (
 	"sync"
 
+	"github.com/ethpandaops/splitoor/pkg/monitor/store"
 	"github.com/sirupsen/logrus"
 )
 
 type Invalid struct {
+	store.AlertTracker
+
 	log logrus.FieldLogger
 
-	alerting bool
-	invalid  error
+	invalid error
 
 	mu sync.Mutex
 }
@@ -21,26 +23,13 @@ func NewInvalid(log logrus.FieldLogger) *Invalid {
 	}
 }
 
-func (m *Invalid) Update(invalid error) (shouldAlert bool) {
+func (m *Invalid) Update(invalid error) (shouldAlert, shouldResolve bool) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
 	shouldBeAlerting := invalid != nil
 
-	if m.alerting {
-		shouldAlert = false
-
-		if !shouldBeAlerting {
-			m.alerting = false
-		}
-	} else {
-		shouldAlert = false
-
-		if shouldBeAlerting {
-			m.alerting = true
-			shouldAlert = true
-		}
-	}
+	shouldAlert, shouldResolve = m.Set(shouldBeAlerting)
 
 	m.invalid = invalid
 
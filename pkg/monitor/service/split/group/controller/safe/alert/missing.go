@@ -3,14 +3,16 @@ package alert
 import (
 	"sync"
 
+	"github.com/ethpandaops/splitoor/pkg/monitor/store"
 	"github.com/sirupsen/logrus"
 )
 
 type Missing struct {
+	store.AlertTracker
+
 	log logrus.FieldLogger
 
-	alerting bool
-	missing  bool
+	missing bool
 
 	mu sync.Mutex
 }
@@ -21,26 +23,13 @@ func NewMissing(log logrus.FieldLogger) *Missing {
 	}
 }
 
-func (m *Missing) Update(missing bool) (shouldAlert bool) {
+func (m *Missing) Update(missing bool) (shouldAlert, shouldResolve bool) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
 	shouldBeAlerting := missing
 
-	if m.alerting {
-		shouldAlert = false
-
-		if !shouldBeAlerting {
-			m.alerting = false
-		}
-	} else {
-		shouldAlert = false
-
-		if shouldBeAlerting {
-			m.alerting = true
-			shouldAlert = true
-		}
-	}
+	shouldAlert, shouldResolve = m.Set(shouldBeAlerting)
 
 	m.missing = missing
 
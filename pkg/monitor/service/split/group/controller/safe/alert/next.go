@@ -3,13 +3,15 @@ package alert
 import (
 	"sync"
 
+	"github.com/ethpandaops/splitoor/pkg/monitor/store"
 	"github.com/sirupsen/logrus"
 )
 
 type Next struct {
+	store.AlertTracker
+
 	log logrus.FieldLogger
 
-	alerting           bool
 	hasRecoveryTx      bool
 	hasRecoveryTxError bool
 	recoveryTxIsNext   bool
@@ -23,27 +25,14 @@ func NewNext(log logrus.FieldLogger) *Next {
 	}
 }
 
-func (n *Next) Update(hasRecoveryTx, hasRecoveryTxError, recoveryTxIsNext bool) (shouldAlert bool) {
+func (n *Next) Update(hasRecoveryTx, hasRecoveryTxError, recoveryTxIsNext bool) (shouldAlert, shouldResolve bool) {
 	n.mu.Lock()
 	defer n.mu.Unlock()
 
 	// only alert if the recovery tx is not next and there is a valid recovery tx
 	shouldBeAlerting := !recoveryTxIsNext && hasRecoveryTx && !hasRecoveryTxError
 
-	if n.alerting {
-		shouldAlert = false
-
-		if !shouldBeAlerting {
-			n.alerting = false
-		}
-	} else {
-		shouldAlert = false
-
-		if shouldBeAlerting {
-			n.alerting = true
-			shouldAlert = true
-		}
-	}
+	shouldAlert, shouldResolve = n.Set(shouldBeAlerting)
 
 	n.hasRecoveryTx = hasRecoveryTx
 	n.hasRecoveryTxError = hasRecoveryTxError
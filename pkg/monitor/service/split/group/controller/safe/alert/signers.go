@@ -1,13 +1,14 @@
 package alert
 
 import (
+	"github.com/ethpandaops/splitoor/pkg/monitor/store"
 	"github.com/sirupsen/logrus"
 )
 
 type Signers struct {
-	log logrus.FieldLogger
+	store.AlertTracker
 
-	lastState bool
+	log logrus.FieldLogger
 }
 
 func NewSigners(log logrus.FieldLogger) *Signers {
@@ -16,16 +17,8 @@ func NewSigners(log logrus.FieldLogger) *Signers {
 	}
 }
 
-// Update returns true if an alert should be triggered
-func (a *Signers) Update(mismatch bool) bool {
-	defer func() {
-		a.lastState = mismatch
-	}()
-
-	// Only alert on state change to true
-	if !a.lastState && mismatch {
-		return true
-	}
-
-	return false
+// Update returns true if an alert should be triggered or resolved
+func (a *Signers) Update(mismatch bool) (shouldAlert, shouldResolve bool) {
+	// Only alert on state change to true, only resolve on state change to false
+	return a.Set(mismatch)
 }
@@ -6,14 +6,18 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/ethpandaops/splitoor/pkg/0xsplits/split"
 	"github.com/ethpandaops/splitoor/pkg/ethereum"
+	mevent "github.com/ethpandaops/splitoor/pkg/monitor/event"
 	event "github.com/ethpandaops/splitoor/pkg/monitor/event/safe"
 	"github.com/ethpandaops/splitoor/pkg/monitor/notifier"
 	"github.com/ethpandaops/splitoor/pkg/monitor/safe"
 	"github.com/ethpandaops/splitoor/pkg/monitor/service/split/group/controller/safe/alert"
+	"github.com/ethpandaops/splitoor/pkg/monitor/status"
+	"github.com/ethpandaops/splitoor/pkg/monitor/store"
 
 	"github.com/sirupsen/logrus"
 )
@@ -48,9 +52,14 @@ type Safe struct {
 	metrics *Metrics
 
 	publisher *notifier.Publisher
+	store     store.Store
+
+	// result of the latest successful queue analysis
+	lastStatus *status.Safe
+	mu         sync.Mutex
 }
 
-func New(ctx context.Context, log logrus.FieldLogger, monitor, name string, config *Config, splitAddress, recoveryAddress, splitsContractAddress string, ethereumPool *ethereum.Pool, safeClient safe.Client, publisher *notifier.Publisher) (*Safe, error) {
+func New(ctx context.Context, log logrus.FieldLogger, monitor, name string, config *Config, splitAddress, recoveryAddress, splitsContractAddress string, ethereumPool *ethereum.Pool, safeClient safe.Client, publisher *notifier.Publisher, st store.Store) (*Safe, error) {
 	// expected recipients when split is in recovery state
 	recoveryAccounts, recoveryAllocations, err := split.ParseRecipients([]string{splitAddress, recoveryAddress}, []uint32{1, 999999})
 	if err != nil {
@@ -77,6 +86,7 @@ func New(ctx context.Context, log logrus.FieldLogger, monitor, name string, conf
 		signersAlert:          alert.NewSigners(log),
 		metrics:               GetMetricsInstance("splitoor_split_controller", monitor),
 		publisher:             publisher,
+		store:                 st,
 	}, nil
 }
 
@@ -87,6 +97,13 @@ func (c *Safe) Start(ctx context.Context) error {
 		return nil
 	}
 
+	c.restoreAlert(ctx, event.SignerMismatchType, c.signersAlert)
+	c.restoreAlert(ctx, event.TransactionQueueExcessType, c.excessQueue)
+	c.restoreAlert(ctx, event.RecoveryTransactionMissingType, c.missing)
+	c.restoreAlert(ctx, event.RecoveryTransactionInvalidType, c.invalid)
+	c.restoreAlert(ctx, event.RecoveryTransactionNotNextType, c.next)
+	c.restoreAlert(ctx, event.RecoveryTransactionConfirmationsType, c.confirmations)
+
 	c.tick(ctx)
 
 	go func() {
@@ -119,6 +136,40 @@ func (c *Safe) Address() string {
 	return c.address
 }
 
+func (c *Safe) Status() *status.Controller {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	st := &status.Controller{
+		Type:    ControllerType,
+		Address: c.address,
+	}
+
+	if c.lastStatus != nil {
+		safeStatus := *c.lastStatus
+		st.Safe = &safeStatus
+	}
+
+	return st
+}
+
+func (c *Safe) Alerts() []*status.Alert {
+	return []*status.Alert{
+		c.alertStatus(event.SignerMismatchType, c.signersAlert),
+		c.alertStatus(event.TransactionQueueExcessType, c.excessQueue),
+		c.alertStatus(event.RecoveryTransactionMissingType, c.missing),
+		c.alertStatus(event.RecoveryTransactionInvalidType, c.invalid),
+		c.alertStatus(event.RecoveryTransactionNotNextType, c.next),
+		c.alertStatus(event.RecoveryTransactionConfirmationsType, c.confirmations),
+	}
+}
+
+func (c *Safe) alertStatus(alertType string, a store.Alert) *status.Alert {
+	state := a.State()
+
+	return status.NewAlert(c.monitor, c.name, alertType, c.address, state.Alerting, state.Since)
+}
+
 func (c *Safe) tick(ctx context.Context) {
 	match, err := c.safeClient.CheckSigners(ctx, c.address)
 	if err != nil {
@@ -127,8 +178,10 @@ func (c *Safe) tick(ctx context.Context) {
 		return
 	}
 
-	shouldAlert := c.signersAlert.Update(!match)
+	shouldAlert, shouldResolve := c.signersAlert.Update(!match)
 	if shouldAlert {
+		c.saveAlert(ctx, event.SignerMismatchType, c.signersAlert)
+
 		c.log.Warn("Alerting signer mismatch")
 
 		if pErr := c.publisher.Publish(event.NewSignerMismatch(time.Now(), c.monitor, c.name, c.address)); pErr != nil {
@@ -136,6 +189,20 @@ func (c *Safe) tick(ctx context.Context) {
 		}
 	}
 
+	if shouldResolve {
+		c.saveAlert(ctx, event.SignerMismatchType, c.signersAlert)
+
+		c.log.Info("Resolving signer mismatch")
+
+		c.publishResolved(event.SignerMismatchType, event.SignerMismatchSeverity, "Safe account has unexpected owners", c.signersAlert.Since())
+	}
+
+	if c.signersAlert.Remind(c.publisher.ReminderInterval(event.SignerMismatchType)) {
+		c.saveAlert(ctx, event.SignerMismatchType, c.signersAlert)
+
+		c.publishReminder(event.NewSignerMismatch(time.Now(), c.monitor, c.name, c.address), c.signersAlert.Since())
+	}
+
 	queued, err := c.safeClient.GetQueuedTransactions(ctx, c.address)
 	if err != nil {
 		c.log.WithError(err).Error("failed to get queued transactions")
@@ -220,8 +287,10 @@ func (c *Safe) tick(ctx context.Context) {
 	/*
 	 * Always alert if the queue is too large
 	 */
-	shouldAlert = c.excessQueue.Update(len(txns))
+	shouldAlert, shouldResolve = c.excessQueue.Update(len(txns))
 	if shouldAlert {
+		c.saveAlert(ctx, event.TransactionQueueExcessType, c.excessQueue)
+
 		c.log.WithFields(logrus.Fields{
 			"length": len(txns),
 		}).Warn("Alerting transaction queue size")
@@ -231,11 +300,27 @@ func (c *Safe) tick(ctx context.Context) {
 		}
 	}
 
+	if shouldResolve {
+		c.saveAlert(ctx, event.TransactionQueueExcessType, c.excessQueue)
+
+		c.log.Info("Resolving transaction queue size")
+
+		c.publishResolved(event.TransactionQueueExcessType, event.TransactionQueueExcessSeverity, "Safe has unexpected transactions in queue", c.excessQueue.Since())
+	}
+
+	if c.excessQueue.Remind(c.publisher.ReminderInterval(event.TransactionQueueExcessType)) {
+		c.saveAlert(ctx, event.TransactionQueueExcessType, c.excessQueue)
+
+		c.publishReminder(event.NewTransactionQueueExcess(time.Now(), c.monitor, c.name, c.address, len(txns)), c.excessQueue.Since())
+	}
+
 	/*
 	 * Alert if no valid or invalid recovery transaction exists
 	 */
-	shouldAlert = c.missing.Update(recoveryTx == "")
+	shouldAlert, shouldResolve = c.missing.Update(recoveryTx == "")
 	if shouldAlert {
+		c.saveAlert(ctx, event.RecoveryTransactionMissingType, c.missing)
+
 		c.log.Warn("Alerting recovery transaction missing")
 
 		if err := c.publisher.Publish(event.NewRecoveryTransactionMissing(time.Now(), c.monitor, c.name, c.address)); err != nil {
@@ -243,11 +328,27 @@ func (c *Safe) tick(ctx context.Context) {
 		}
 	}
 
+	if shouldResolve {
+		c.saveAlert(ctx, event.RecoveryTransactionMissingType, c.missing)
+
+		c.log.Info("Resolving recovery transaction missing")
+
+		c.publishResolved(event.RecoveryTransactionMissingType, event.RecoveryTransactionMissingSeverity, "Safe account has no recovery transaction queued", c.missing.Since())
+	}
+
+	if c.missing.Remind(c.publisher.ReminderInterval(event.RecoveryTransactionMissingType)) {
+		c.saveAlert(ctx, event.RecoveryTransactionMissingType, c.missing)
+
+		c.publishReminder(event.NewRecoveryTransactionMissing(time.Now(), c.monitor, c.name, c.address), c.missing.Since())
+	}
+
 	/*
 	 * Alert if an ivalid recovery transaction exists
 	 */
-	shouldAlert = c.invalid.Update(invalidRecoveryError)
+	shouldAlert, shouldResolve = c.invalid.Update(invalidRecoveryError)
 	if shouldAlert {
+		c.saveAlert(ctx, event.RecoveryTransactionInvalidType, c.invalid)
+
 		c.log.WithFields(logrus.Fields{
 			"tx_id": recoveryTx,
 		}).WithError(invalidRecoveryError).Warn("Alerting recovery transaction invalid")
@@ -257,11 +358,27 @@ func (c *Safe) tick(ctx context.Context) {
 		}
 	}
 
+	if shouldResolve {
+		c.saveAlert(ctx, event.RecoveryTransactionInvalidType, c.invalid)
+
+		c.log.Info("Resolving recovery transaction invalid")
+
+		c.publishResolved(event.RecoveryTransactionInvalidType, event.RecoveryTransactionInvalidSeverity, "Safe account has invalid recovery transaction", c.invalid.Since())
+	}
+
+	if invalidRecoveryError != nil && c.invalid.Remind(c.publisher.ReminderInterval(event.RecoveryTransactionInvalidType)) {
+		c.saveAlert(ctx, event.RecoveryTransactionInvalidType, c.invalid)
+
+		c.publishReminder(event.NewRecoveryTransactionInvalid(time.Now(), c.monitor, c.name, c.address, recoveryTx, invalidRecoveryError.Error()), c.invalid.Since())
+	}
+
 	/*
 	 * Alert if a valid recovery transaction is not next in the queue
 	 */
-	shouldAlert = c.next.Update(recoveryTx != "", invalidRecoveryError == nil, hasNextRecoveryTx)
+	shouldAlert, shouldResolve = c.next.Update(recoveryTx != "", invalidRecoveryError == nil, hasNextRecoveryTx)
 	if shouldAlert {
+		c.saveAlert(ctx, event.RecoveryTransactionNotNextType, c.next)
+
 		c.log.WithFields(logrus.Fields{
 			"tx_id": recoveryTx,
 		}).Warn("Alerting recovery transaction not next")
@@ -271,6 +388,20 @@ func (c *Safe) tick(ctx context.Context) {
 		}
 	}
 
+	if shouldResolve {
+		c.saveAlert(ctx, event.RecoveryTransactionNotNextType, c.next)
+
+		c.log.Info("Resolving recovery transaction not next")
+
+		c.publishResolved(event.RecoveryTransactionNotNextType, event.RecoveryTransactionNotNextSeverity, "Safe account has a recovery transaction that is not next in queue", c.next.Since())
+	}
+
+	if c.next.Remind(c.publisher.ReminderInterval(event.RecoveryTransactionNotNextType)) {
+		c.saveAlert(ctx, event.RecoveryTransactionNotNextType, c.next)
+
+		c.publishReminder(event.NewRecoveryTransactionNotNext(time.Now(), c.monitor, c.name, c.address, recoveryTx), c.next.Since())
+	}
+
 	expectedConfirmations := requiredConfirmations - 1
 	// handle special case where a safe multisig only requires 1 confirmation
 	if requiredConfirmations == 1 {
@@ -280,8 +411,10 @@ func (c *Safe) tick(ctx context.Context) {
 	/*
 	 * Alert if a valid next recovery transaction is not pre-signed
 	 */
-	shouldAlert = c.confirmations.Update(currentConfirmations, expectedConfirmations, hasNextRecoveryTx)
+	shouldAlert, shouldResolve = c.confirmations.Update(currentConfirmations, expectedConfirmations, hasNextRecoveryTx)
 	if shouldAlert {
+		c.saveAlert(ctx, event.RecoveryTransactionConfirmationsType, c.confirmations)
+
 		c.log.WithFields(logrus.Fields{
 			"current_confirmations":  currentConfirmations,
 			"expected_confirmations": expectedConfirmations,
@@ -296,12 +429,70 @@ func (c *Safe) tick(ctx context.Context) {
 		}
 	}
 
+	if shouldResolve {
+		c.saveAlert(ctx, event.RecoveryTransactionConfirmationsType, c.confirmations)
+
+		c.log.Info("Resolving recovery transaction not pre-signed")
+
+		c.publishResolved(event.RecoveryTransactionConfirmationsType, event.RecoveryTransactionConfirmationsSeverity, "Safe account has a recovery transaction with incorrect number of confirmations", c.confirmations.Since())
+	}
+
+	if c.confirmations.Remind(c.publisher.ReminderInterval(event.RecoveryTransactionConfirmationsType)) {
+		c.saveAlert(ctx, event.RecoveryTransactionConfirmationsType, c.confirmations)
+
+		c.publishReminder(event.NewRecoveryTransactionConfirmations(time.Now(), c.monitor, c.name, c.address, recoveryTx, currentConfirmations, expectedConfirmations), c.confirmations.Since())
+	}
+
+	lastStatus := &status.Safe{
+		Address:                  c.address,
+		SignersMatch:             match,
+		QueueSize:                len(txns),
+		RecoveryTransaction:      recoveryTx,
+		RecoveryTransactionValid: recoveryTx != "" && invalidRecoveryError == nil,
+		RecoveryTransactionNext:  hasNextRecoveryTx,
+		Confirmations:            currentConfirmations,
+		ExpectedConfirmations:    expectedConfirmations,
+		UpdatedAt:                time.Now(),
+	}
+
+	if invalidRecoveryError != nil {
+		lastStatus.RecoveryTransactionError = invalidRecoveryError.Error()
+	}
+
+	c.mu.Lock()
+	c.lastStatus = lastStatus
+	c.mu.Unlock()
+
 	c.metrics.UpdateTransactionRecoveryValid(boolToFloat64(recoveryTx != "" && invalidRecoveryError == nil), []string{c.name, c.address, c.Type()})
 	c.metrics.UpdateTransactionRecoveryExists(boolToFloat64(recoveryTx != ""), []string{c.name, c.address, c.Type()})
 	c.metrics.UpdateTransactionRecoveryNext(boolToFloat64(hasNextRecoveryTx), []string{c.name, c.address, c.Type()})
 	c.metrics.UpdateTransactionRecoveryPreSigned(boolToFloat64(currentConfirmations == expectedConfirmations), []string{c.name, c.address, c.Type(), strconv.Itoa(expectedConfirmations), strconv.Itoa(currentConfirmations)})
 }
 
+func (c *Safe) restoreAlert(ctx context.Context, alertType string, a store.Alert) {
+	if err := store.RestoreAlert(ctx, c.store, store.AlertKey(c.monitor, c.name, alertType, ""), a); err != nil {
+		c.log.WithError(err).WithField("alert_type", alertType).Error("Error restoring alert state")
+	}
+}
+
+func (c *Safe) saveAlert(ctx context.Context, alertType string, a store.Alert) {
+	if err := store.SaveAlert(ctx, c.store, store.AlertKey(c.monitor, c.name, alertType, ""), a); err != nil {
+		c.log.WithError(err).WithField("alert_type", alertType).Error("Error saving alert state")
+	}
+}
+
+func (c *Safe) publishResolved(alertType string, severity mevent.Severity, alertTitle string, since time.Time) {
+	if err := c.publisher.Publish(mevent.NewResolved(time.Now(), since, c.monitor, c.name, alertType, severity, alertTitle, c.address)); err != nil {
+		c.log.WithError(err).WithField("alert_type", alertType).Error("Error publishing resolved alert")
+	}
+}
+
+func (c *Safe) publishReminder(e mevent.Event, since time.Time) {
+	if err := c.publisher.Publish(mevent.NewReminder(time.Now(), since, e)); err != nil {
+		c.log.WithError(err).WithField("alert_type", e.GetType()).Error("Error publishing alert reminder")
+	}
+}
+
 func boolToFloat64(b bool) float64 {
 	if b {
 		return 1
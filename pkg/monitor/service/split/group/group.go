@@ -3,18 +3,24 @@ package group
 import (
 	"context"
 	"encoding/hex"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/0xsequence/ethkit/ethcoder"
 	"github.com/ethpandaops/splitoor/pkg/0xsplits/contract"
 	spl "github.com/ethpandaops/splitoor/pkg/0xsplits/split"
 	"github.com/ethpandaops/splitoor/pkg/ethereum"
+	mevent "github.com/ethpandaops/splitoor/pkg/monitor/event"
 	event "github.com/ethpandaops/splitoor/pkg/monitor/event/split"
 	"github.com/ethpandaops/splitoor/pkg/monitor/notifier"
 	"github.com/ethpandaops/splitoor/pkg/monitor/safe"
 	"github.com/ethpandaops/splitoor/pkg/monitor/service/split/group/account"
 	"github.com/ethpandaops/splitoor/pkg/monitor/service/split/group/alert"
 	"github.com/ethpandaops/splitoor/pkg/monitor/service/split/group/controller"
+	"github.com/ethpandaops/splitoor/pkg/monitor/service/split/group/token"
+	"github.com/ethpandaops/splitoor/pkg/monitor/status"
+	"github.com/ethpandaops/splitoor/pkg/monitor/store"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
@@ -33,6 +39,7 @@ type Group struct {
 	publisher    *notifier.Publisher
 	ethereumPool *ethereum.Pool
 	safeClient   safe.Client
+	store        store.Store
 
 	address         string
 	recoveryAddress string
@@ -45,16 +52,46 @@ type Group struct {
 	stableHash   string
 	accounts     []*account.Account
 	controller   controller.Controller
+	tokens       []*token.Token
+	tokenAlerts  map[string]*tokenAlerts
 
 	metrics *Metrics
+	state   *State
+
+	// lastTick is when the split hash was last successfully read from a node
+	lastTick time.Time
+	mu       sync.Mutex
+
+	// checks are the running checks of the current tick, Stop waits for them so their
+	// events are published before the publisher stops
+	checks  sync.WaitGroup
+	stopped bool
+	stopMu  sync.Mutex
 
 	hashUnknownAlert  *alert.HashUnknown
 	hashInitialAlert  *alert.HashInitial
 	hashRecoveryAlert *alert.HashRecovery
 	controllerAlert   *alert.Controller
+	immutableAlert    *alert.Immutable
+
+	// controllerChange is the last published controller change, so a change is only published
+	// once when it is seen by every node
+	controllerChange string
+
+	pendingControlTransferAlert *alert.PendingControlTransfer
+
+	logs      LogsConfig
+	logsMu    sync.Mutex
+	nextBlock *uint64
+
+	// recipients are the latest known recipients of the split, used to explain an unknown hash
+	recipients         *recipients
+	recipientsMu       sync.Mutex
+	recipientsSearched string
+	recipientsSearchMu sync.Mutex
 }
 
-func NewGroup(ctx context.Context, log logrus.FieldLogger, monitor string, conf *Config, ethereumPool *ethereum.Pool, publisher *notifier.Publisher, safeClient safe.Client) (*Group, error) {
+func NewGroup(ctx context.Context, log logrus.FieldLogger, monitor string, conf *Config, ethereumPool *ethereum.Pool, publisher *notifier.Publisher, safeClient safe.Client, st store.Store) (*Group, error) {
 	log = log.WithField("group", conf.Name)
 
 	var c string
@@ -64,12 +101,22 @@ func NewGroup(ctx context.Context, log logrus.FieldLogger, monitor string, conf
 	}
 
 	accounts := make([]*account.Account, len(conf.Accounts))
+	accountAddresses := make([]string, len(conf.Accounts))
 
 	for i, acc := range conf.Accounts {
 		accounts[i] = account.NewAccount(log, monitor, conf.Name, acc.Address, acc.Allocation, acc.Monitor, ethereumPool)
+		accountAddresses[i] = acc.Address
+	}
+
+	tokens := make([]*token.Token, len(conf.Tokens))
+	tokenAlerts := make(map[string]*tokenAlerts, len(conf.Tokens))
+
+	for i, t := range conf.Tokens {
+		tokens[i] = token.NewToken(log, monitor, conf.Name, conf.Address, t, accountAddresses)
+		tokenAlerts[tokens[i].Address()] = newTokenAlerts(log, tokens[i])
 	}
 
-	ctr, err := controller.NewController(ctx, log, monitor, conf.Name, conf.Controller.ControllerType, conf.Controller.Config, conf.Address, conf.RecoveryAddress, c, ethereumPool, safeClient, publisher)
+	ctr, err := controller.NewController(ctx, log, monitor, conf.Name, conf.Controller.ControllerType, conf.Controller.Config, conf.Address, conf.RecoveryAddress, c, ethereumPool, safeClient, publisher, st)
 	if err != nil {
 		return nil, err
 	}
@@ -81,16 +128,24 @@ func NewGroup(ctx context.Context, log logrus.FieldLogger, monitor string, conf
 		publisher:         publisher,
 		ethereumPool:      ethereumPool,
 		safeClient:        safeClient,
+		store:             st,
 		address:           conf.Address,
 		recoveryAddress:   conf.RecoveryAddress,
 		contract:          c,
 		accounts:          accounts,
 		controller:        ctr,
+		tokens:            tokens,
+		tokenAlerts:       tokenAlerts,
 		metrics:           GetMetricsInstance("splitoor_split", monitor),
+		state:             NewState(log),
 		hashUnknownAlert:  nil,
 		hashInitialAlert:  nil,
 		hashRecoveryAlert: nil,
 		controllerAlert:   alert.NewController(log, ctr.Address()),
+		immutableAlert:    alert.NewImmutable(log),
+
+		pendingControlTransferAlert: alert.NewPendingControlTransfer(log, ctr.Address()),
+		logs:                        conf.Logs,
 	}, nil
 }
 
@@ -105,6 +160,15 @@ func (g *Group) Start(ctx context.Context) error {
 		return err
 	}
 
+	g.restoreAlert(ctx, event.ControllerType, g.controllerAlert)
+	g.restoreAlert(ctx, event.ImmutableType, g.immutableAlert)
+	g.restoreAlert(ctx, event.PendingControlTransferType, g.pendingControlTransferAlert)
+	g.restoreAlert(ctx, event.HashUnknownStateType, g.hashUnknownAlert)
+	g.restoreAlert(ctx, event.HashInitialStateType, g.hashInitialAlert)
+	g.restoreAlert(ctx, event.HashRecoveryStateType, g.hashRecoveryAlert)
+	g.restoreTokenAlerts(ctx)
+	g.restoreLogs(ctx)
+
 	for _, account := range g.accounts {
 		if err := account.Start(ctx); err != nil {
 			return err
@@ -128,6 +192,12 @@ func (g *Group) Start(ctx context.Context) error {
 }
 
 func (g *Group) Stop(ctx context.Context) error {
+	g.stopMu.Lock()
+	g.stopped = true
+	g.stopMu.Unlock()
+
+	g.checks.Wait()
+
 	if g.controller != nil {
 		if err := g.controller.Stop(ctx); err != nil {
 			return err
@@ -189,6 +259,11 @@ func (g *Group) setupSplit(ctx context.Context) error {
 		account.SetContract(g.contractABI)
 	}
 
+	for _, t := range g.tokens {
+		t.SetClient(g.client)
+		t.SetContract(g.contractABI)
+	}
+
 	// stable hash accounts and allocations
 	accounts := []string{}
 	allocations := []uint32{}
@@ -236,9 +311,29 @@ func (g *Group) setupSplit(ctx context.Context) error {
 }
 
 func (g *Group) tick(ctx context.Context) {
-	go g.checkController(ctx)
-	go g.checkHash(ctx)
-	go g.gatherMetrics(ctx)
+	g.stopMu.Lock()
+	defer g.stopMu.Unlock()
+
+	if g.stopped {
+		return
+	}
+
+	for _, check := range []func(context.Context){
+		g.checkController,
+		g.checkPendingControlTransfer,
+		g.checkHash,
+		g.gatherMetrics,
+		g.checkTokens,
+		g.scanLogs,
+	} {
+		g.checks.Add(1)
+
+		go func() {
+			defer g.checks.Done()
+
+			check(ctx)
+		}()
+	}
 }
 
 func (g *Group) checkController(ctx context.Context) {
@@ -268,22 +363,132 @@ func (g *Group) checkController(ctx context.Context) {
 
 		g.metrics.UpdateController(val, []string{g.name, node.Name(), g.address, g.controller.Address(), *actualController, g.controller.Type()})
 
-		shouldAlert := g.controllerAlert.Update(*actualController)
-		if shouldAlert {
-			g.log.WithFields(logrus.Fields{
-				"split_address":       g.address,
-				"expected_controller": g.controller.Address(),
-				"actual_controller":   *actualController,
-			}).Warn("Alerting controller mismatch")
-
-			if err := g.publisher.Publish(event.NewController(time.Now(), g.monitor, g.name, g.address, g.controller.Address(), *actualController)); err != nil {
-				g.log.WithError(err).WithFields(logrus.Fields{
-					"split_address":       g.address,
-					"expected_controller": g.controller.Address(),
-					"actual_controller":   *actualController,
-				}).Error("Error publishing controller mismatch alert")
-			}
+		previousController := g.state.Controller(node.Name())
+		g.state.UpdateController(node.Name(), *actualController)
+
+		if previousController != "" && !strings.EqualFold(previousController, *actualController) {
+			g.publishControllerChange(previousController, *actualController)
 		}
+
+		g.checkImmutable(ctx, node.Name(), *actualController)
+
+		shouldAlert, shouldResolve := g.controllerAlert.Update(*actualController)
+
+		log := g.log.WithFields(logrus.Fields{
+			"split_address":       g.address,
+			"expected_controller": g.controller.Address(),
+			"actual_controller":   *actualController,
+		})
+
+		g.notifyAlert(ctx, log, g.controllerAlert, event.ControllerType, "", "controller mismatch", "Split controller has changed", shouldAlert, shouldResolve, func() mevent.Event {
+			return event.NewController(time.Now(), g.monitor, g.name, g.address, g.controller.Address(), *actualController)
+		})
+	}
+}
+
+// publishControllerChange publishes a change of the controller seen by a node between two
+// unexpected controllers, the mismatch alert is already firing and doesn't notify about it.
+// Changes from or to the expected controller and to the zero address are notified by the
+// controller and immutable alerts.
+func (g *Group) publishControllerChange(previousController, actualController string) {
+	change := strings.ToLower(previousController + "/" + actualController)
+
+	g.mu.Lock()
+	published := g.controllerChange == change
+	g.controllerChange = change
+	g.mu.Unlock()
+
+	if published {
+		return
+	}
+
+	g.metrics.IncControllerChanges([]string{g.name, g.address, previousController, actualController})
+
+	log := g.log.WithFields(logrus.Fields{
+		"split_address":       g.address,
+		"expected_controller": g.controller.Address(),
+		"previous_controller": previousController,
+		"actual_controller":   actualController,
+	})
+
+	log.Warn("Split controller changed")
+
+	if strings.EqualFold(previousController, g.controller.Address()) ||
+		strings.EqualFold(actualController, g.controller.Address()) ||
+		actualController == event.ZeroAddress {
+		return
+	}
+
+	if err := g.publisher.Publish(event.NewControllerChanged(time.Now(), g.monitor, g.name, g.address, g.controller.Address(), previousController, actualController)); err != nil {
+		log.WithError(err).Error("Error publishing controller changed event")
+	}
+}
+
+func (g *Group) checkImmutable(ctx context.Context, source, actualController string) {
+	immutableVal := float64(0)
+	if actualController == event.ZeroAddress {
+		immutableVal = 1
+	}
+
+	g.metrics.UpdateImmutable(immutableVal, []string{g.name, source, g.address})
+
+	shouldAlert, shouldResolve := g.immutableAlert.Update(actualController)
+
+	log := g.log.WithFields(logrus.Fields{
+		"split_address":       g.address,
+		"expected_controller": g.controller.Address(),
+		"actual_controller":   actualController,
+	})
+
+	g.notifyAlert(ctx, log, g.immutableAlert, event.ImmutableType, "", "split is immutable", "Split is immutable", shouldAlert, shouldResolve, func() mevent.Event {
+		return event.NewImmutable(time.Now(), g.monitor, g.name, g.address, g.controller.Address())
+	})
+}
+
+func (g *Group) checkPendingControlTransfer(ctx context.Context) {
+	for _, node := range g.ethereumPool.GetHealthyExecutionNodes() {
+		if ctx.Err() != nil {
+			return
+		}
+
+		potentialController, err := g.client.GetNewPotentialController(ctx, node, g.contractABI)
+		if err != nil {
+			g.log.WithError(err).Error("Error fetching new potential controller")
+
+			continue
+		}
+
+		if potentialController == nil {
+			g.log.WithField("node", node.Name()).Error("New potential controller is nil")
+
+			continue
+		}
+
+		val := float64(0)
+		if *potentialController != event.ZeroAddress {
+			val = 1
+		}
+
+		// the series of a cancelled or accepted transfer would otherwise report it as pending forever
+		previousPotentialController := g.state.PotentialController(node.Name())
+		if previousPotentialController != "" && previousPotentialController != *potentialController {
+			g.metrics.DeletePotentialController([]string{g.name, node.Name(), g.address, g.controller.Address(), previousPotentialController})
+		}
+
+		g.metrics.UpdatePotentialController(val, []string{g.name, node.Name(), g.address, g.controller.Address(), *potentialController})
+		g.state.UpdatePotentialController(node.Name(), *potentialController)
+
+		shouldAlert, shouldResolve := g.pendingControlTransferAlert.Update(*potentialController)
+
+		log := g.log.WithFields(logrus.Fields{
+			"split_address":        g.address,
+			"expected_controller":  g.controller.Address(),
+			"potential_controller": *potentialController,
+		})
+
+		g.notifyAlert(ctx, log, g.pendingControlTransferAlert, event.PendingControlTransferType, "", "pending control transfer", "Split control transfer pending", shouldAlert, shouldResolve, func() mevent.Event {
+			return event.NewPendingControlTransfer(time.Now(), g.monitor, g.name, g.address, g.controller.Address(), *potentialController)
+		})
 	}
 }
 
@@ -302,78 +507,69 @@ func (g *Group) checkHash(ctx context.Context) {
 
 		actualHashString := hex.EncodeToString(actualHash[:])
 
+		hashState := HashStateUnknown
+
 		stableHashVal := float64(0)
 		if actualHashString == g.stableHash {
 			stableHashVal = 1
+			hashState = HashStateStable
 		}
 
 		initialHashVal := float64(0)
 		if actualHashString == g.initialHash {
 			initialHashVal = 1
+			hashState = HashStateInitial
 		}
 
 		recoveryHashVal := float64(0)
 		if actualHashString == g.recoveryHash {
 			recoveryHashVal = 1
+			hashState = HashStateRecovery
 		}
 
+		g.state.UpdateHash(node.Name(), actualHashString, hashState)
+
+		if hashState == HashStateUnknown {
+			g.reconstructRecipients(ctx, node, actualHashString)
+		}
+
+		g.mu.Lock()
+		g.lastTick = time.Now()
+		g.mu.Unlock()
+
 		g.metrics.UpdateHashStable(stableHashVal, []string{g.name, node.Name(), g.address, g.stableHash, actualHashString})
 		g.metrics.UpdateHashInitial(initialHashVal, []string{g.name, node.Name(), g.address, g.initialHash, actualHashString})
 		g.metrics.UpdateHashRecovery(recoveryHashVal, []string{g.name, node.Name(), g.address, g.recoveryHash, actualHashString})
 
-		shouldAlertUnknown := g.hashUnknownAlert.Update(actualHashString)
-		if shouldAlertUnknown {
-			g.log.WithFields(logrus.Fields{
-				"split_address": g.address,
-				"expected_hash": g.stableHash,
-				"actual_hash":   actualHashString,
-			}).Warn("Alerting stable hash unknown")
-
-			if err := g.publisher.Publish(event.NewHashUnknownState(time.Now(), g.monitor, g.name, g.address, g.stableHash, actualHashString)); err != nil {
-				g.log.WithError(err).WithFields(logrus.Fields{
-					"split_address": g.address,
-					"expected_hash": g.stableHash,
-					"actual_hash":   actualHashString,
-				}).Error("Error publishing hash unknown alert")
-			}
-		}
+		shouldAlertUnknown, shouldResolveUnknown := g.hashUnknownAlert.Update(actualHashString)
 
-		shouldAlertInitial := g.hashInitialAlert.Update(actualHashString)
-		if shouldAlertInitial {
-			g.log.WithFields(logrus.Fields{
-				"split_address": g.address,
-				"expected_hash": g.initialHash,
-				"actual_hash":   actualHashString,
-			}).Warn("Alerting in initial hash state")
-
-			if err := g.publisher.Publish(event.NewHashInitialState(time.Now(), g.monitor, g.name, g.address, actualHashString)); err != nil {
-				g.log.WithError(err).WithFields(logrus.Fields{
-					"split_address": g.address,
-					"expected_hash": g.initialHash,
-					"actual_hash":   actualHashString,
-				}).Error("Error publishing in initial hash state alert")
-			}
-		}
+		g.notifyAlert(ctx, g.hashLog(g.stableHash, actualHashString), g.hashUnknownAlert, event.HashUnknownStateType, "", "stable hash unknown", "Split hash is in unknown state", shouldAlertUnknown, shouldResolveUnknown, func() mevent.Event {
+			return g.newHashUnknownState(actualHashString)
+		})
 
-		shouldAlertRecovery := g.hashRecoveryAlert.Update(actualHashString)
-		if shouldAlertRecovery {
-			g.log.WithFields(logrus.Fields{
-				"split_address": g.address,
-				"expected_hash": g.recoveryHash,
-				"actual_hash":   actualHashString,
-			}).Warn("Alerting in recovery hash state")
-
-			if err := g.publisher.Publish(event.NewHashRecoveryState(time.Now(), g.monitor, g.name, g.address, actualHashString)); err != nil {
-				g.log.WithError(err).WithFields(logrus.Fields{
-					"split_address": g.address,
-					"expected_hash": g.recoveryHash,
-					"actual_hash":   actualHashString,
-				}).Error("Error publishing in recovery hash state alert")
-			}
-		}
+		shouldAlertInitial, shouldResolveInitial := g.hashInitialAlert.Update(actualHashString)
+
+		g.notifyAlert(ctx, g.hashLog(g.initialHash, actualHashString), g.hashInitialAlert, event.HashInitialStateType, "", "in initial hash state", "Split hash is in initial state", shouldAlertInitial, shouldResolveInitial, func() mevent.Event {
+			return event.NewHashInitialState(time.Now(), g.monitor, g.name, g.address, actualHashString)
+		})
+
+		shouldAlertRecovery, shouldResolveRecovery := g.hashRecoveryAlert.Update(actualHashString)
+
+		g.notifyAlert(ctx, g.hashLog(g.recoveryHash, actualHashString), g.hashRecoveryAlert, event.HashRecoveryStateType, "", "in recovery hash state", "Split hash is in recovery state", shouldAlertRecovery, shouldResolveRecovery, func() mevent.Event {
+			return event.NewHashRecoveryState(time.Now(), g.monitor, g.name, g.address, actualHashString)
+		})
 	}
 }
 
+// hashLog returns the logger of a hash alert comparing actualHash against expectedHash.
+func (g *Group) hashLog(expectedHash, actualHash string) logrus.FieldLogger {
+	return g.log.WithFields(logrus.Fields{
+		"split_address": g.address,
+		"expected_hash": expectedHash,
+		"actual_hash":   actualHash,
+	})
+}
+
 func (g *Group) gatherMetrics(ctx context.Context) {
 	for _, node := range g.ethereumPool.GetHealthyExecutionNodes() {
 		balance, err := node.BalanceAt(ctx, g.address)
@@ -388,5 +584,151 @@ func (g *Group) gatherMetrics(ctx context.Context) {
 		}
 
 		g.metrics.UpdateBalance(float64(balance.Uint64()), []string{g.name, node.Name(), g.address})
+		g.state.UpdateBalance(node.Name(), balance.String())
+	}
+}
+
+func (g *Group) Name() string {
+	return g.name
+}
+
+// Status returns a snapshot of the split as last seen by each execution node.
+func (g *Group) Status() *status.SplitGroup {
+	sources := make(map[string]*status.SplitSource)
+
+	for source, split := range g.state.Copy() {
+		sources[source] = &status.SplitSource{
+			Controller:          split.Controller,
+			PotentialController: split.PotentialController,
+			Hash:                split.Hash,
+			HashState:           split.HashState,
+			Balance:             split.Balance,
+			UpdatedAt:           split.UpdatedAt,
+		}
+	}
+
+	accounts := make([]*status.SplitAccount, len(g.accounts))
+
+	for i, account := range g.accounts {
+		accounts[i] = account.Status()
+	}
+
+	tokens := make([]*status.SplitToken, len(g.tokens))
+
+	for i, t := range g.tokens {
+		tokens[i] = t.Status()
+	}
+
+	g.mu.Lock()
+	lastTick := g.lastTick
+	g.mu.Unlock()
+
+	return &status.SplitGroup{
+		Name:            g.name,
+		Address:         g.address,
+		RecoveryAddress: g.recoveryAddress,
+		Contract:        g.contract,
+		Controller:      g.controller.Status(),
+		StableHash:      g.stableHash,
+		InitialHash:     g.initialHash,
+		RecoveryHash:    g.recoveryHash,
+		Sources:         sources,
+		Accounts:        accounts,
+		Tokens:          tokens,
+		Recipients:      g.recipientsStatus(sources),
+		Alerts:          g.Alerts(),
+		LastTick:        status.TimePtr(lastTick),
+	}
+}
+
+// Alerts returns the state of every alert of the split, including the controller alerts.
+func (g *Group) Alerts() []*status.Alert {
+	alerts := []*status.Alert{
+		g.alertStatus(event.ControllerType, g.controllerAlert),
+		g.alertStatus(event.ImmutableType, g.immutableAlert),
+		g.alertStatus(event.PendingControlTransferType, g.pendingControlTransferAlert),
+	}
+
+	// hash alerts are only created once the split has been set up
+	if g.hashUnknownAlert != nil {
+		alerts = append(alerts,
+			g.alertStatus(event.HashUnknownStateType, g.hashUnknownAlert),
+			g.alertStatus(event.HashInitialStateType, g.hashInitialAlert),
+			g.alertStatus(event.HashRecoveryStateType, g.hashRecoveryAlert),
+		)
+	}
+
+	alerts = append(alerts, g.tokenAlertStatuses()...)
+
+	return append(alerts, g.controller.Alerts()...)
+}
+
+func (g *Group) alertStatus(alertType string, a store.Alert) *status.Alert {
+	state := a.State()
+
+	return status.NewAlert(g.monitor, g.name, alertType, g.address, state.Alerting, state.Since)
+}
+
+func (g *Group) restoreAlert(ctx context.Context, alertType string, a store.Alert) {
+	if err := store.RestoreAlert(ctx, g.store, store.AlertKey(g.monitor, g.name, alertType, ""), a); err != nil {
+		g.log.WithError(err).WithField("alert_type", alertType).Error("Error restoring alert state")
+	}
+}
+
+// reminderAlert is an alert of the group that is stored and reminded while it is firing.
+type reminderAlert interface {
+	store.Alert
+	Since() time.Time
+	Remind(interval time.Duration) bool
+}
+
+// notifyAlert publishes an updated alert when it starts firing, is resolved or is due for a
+// reminder, and saves its state whenever it changes. The alert is stored under subject, empty
+// for alerts the group holds once. name describes the alert in logs and title is the title of
+// its resolved event.
+func (g *Group) notifyAlert(ctx context.Context, log logrus.FieldLogger, a reminderAlert, alertType, subject, name, title string, shouldAlert, shouldResolve bool, newEvent func() mevent.Event) {
+	key := store.AlertKey(g.monitor, g.name, alertType, subject)
+
+	save := func() {
+		if err := store.SaveAlert(ctx, g.store, key, a); err != nil {
+			log.WithError(err).WithField("alert_type", alertType).Error("Error saving alert state")
+		}
+	}
+
+	if shouldAlert {
+		save()
+
+		log.Warn("Alerting " + name)
+
+		if err := g.publisher.Publish(newEvent()); err != nil {
+			log.WithError(err).Error("Error publishing " + name + " alert")
+		}
+	}
+
+	if shouldResolve {
+		save()
+
+		log.Info("Resolving " + name)
+
+		e := newEvent()
+
+		if err := g.publisher.Publish(mevent.NewResolved(time.Now(), a.Since(), g.monitor, g.name, alertType, e.GetSeverity(), title, mevent.GetSubject(e))); err != nil {
+			log.WithError(err).Error("Error publishing resolved alert")
+		}
+	}
+
+	if a.Remind(g.publisher.ReminderInterval(alertType)) {
+		save()
+
+		g.publishReminder(newEvent(), a.Since())
+	}
+}
+
+func (g *Group) publishReminder(e mevent.Event, since time.Time) {
+	if err := g.publisher.Publish(mevent.NewReminder(time.Now(), since, e)); err != nil {
+		g.log.WithError(err).WithFields(logrus.Fields{
+			"split_address": g.address,
+			"alert_type":    e.GetType(),
+		}).Error("Error publishing alert reminder")
 	}
 }
@@ -6,6 +6,13 @@ import (
 	spl "github.com/ethpandaops/splitoor/pkg/0xsplits/split"
 )
 
+const (
+	HashStateStable   = "stable"
+	HashStateInitial  = "initial"
+	HashStateRecovery = "recovery"
+	HashStateUnknown  = "unknown"
+)
+
 func calculateHash(accounts []string, allocations []uint32) (string, error) {
 	initialHashParams := &spl.HashParams{
 		Accounts:              accounts,
@@ -0,0 +1,240 @@
+package group
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	spl "github.com/ethpandaops/splitoor/pkg/0xsplits/split"
+	"github.com/ethpandaops/splitoor/pkg/ethereum/execution"
+	event "github.com/ethpandaops/splitoor/pkg/monitor/event/split"
+	"github.com/sirupsen/logrus"
+)
+
+// logsState is the persisted progress of the log scanner.
+type logsState struct {
+	NextBlock uint64 `json:"nextBlock"`
+}
+
+func (g *Group) logsKey() string {
+	return strings.Join([]string{"logs", g.monitor, g.name}, "/")
+}
+
+func (g *Group) restoreLogs(ctx context.Context) {
+	data, err := g.store.Get(ctx, g.logsKey())
+	if err != nil {
+		g.log.WithError(err).Error("Error restoring log scanner state")
+
+		return
+	}
+
+	if data == nil {
+		return
+	}
+
+	var state logsState
+	if err := json.Unmarshal(data, &state); err != nil {
+		g.log.WithError(err).Error("Error decoding log scanner state")
+
+		return
+	}
+
+	g.nextBlock = &state.NextBlock
+}
+
+func (g *Group) saveLogs(ctx context.Context) {
+	data, err := json.Marshal(&logsState{NextBlock: *g.nextBlock})
+	if err != nil {
+		g.log.WithError(err).Error("Error encoding log scanner state")
+
+		return
+	}
+
+	if err := g.store.Set(ctx, g.logsKey(), data); err != nil {
+		g.log.WithError(err).Error("Error saving log scanner state")
+	}
+}
+
+// scanLogs publishes an event for every SplitMain log of the split since the last scan.
+// Only blocks with enough confirmations are scanned so logs of reorged blocks are not
+// published, the logs of those blocks are read from a single node.
+func (g *Group) scanLogs(ctx context.Context) {
+	if !g.logs.IsEnabled() {
+		return
+	}
+
+	// a catch up scan can take longer than a tick
+	if !g.logsMu.TryLock() {
+		return
+	}
+	defer g.logsMu.Unlock()
+
+	nodes := g.ethereumPool.GetHealthyExecutionNodes()
+	if len(nodes) == 0 {
+		return
+	}
+
+	node := nodes[0]
+
+	head, err := node.BlockNumber(ctx)
+	if err != nil {
+		g.log.WithError(err).WithField("node", node.Name()).Error("Error fetching block number")
+
+		return
+	}
+
+	confirmations := g.logs.GetConfirmations()
+	if *head < confirmations {
+		return
+	}
+
+	confirmed := *head - confirmations
+
+	if g.nextBlock == nil {
+		next := confirmed + 1
+		if g.logs.StartBlock != nil {
+			next = *g.logs.StartBlock
+		}
+
+		g.nextBlock = &next
+		g.saveLogs(ctx)
+	}
+
+	accounts := []string{g.recoveryAddress}
+	for _, account := range g.accounts {
+		accounts = append(accounts, account.Address())
+	}
+
+	for *g.nextBlock <= confirmed {
+		if ctx.Err() != nil {
+			return
+		}
+
+		from := *g.nextBlock
+		to := min(from+g.logs.GetMaxBlockRange()-1, confirmed)
+
+		events, err := g.client.GetContractEvents(ctx, node, g.contractABI, from, to, accounts)
+		if err != nil {
+			g.log.WithError(err).WithFields(logrus.Fields{
+				"node":       node.Name(),
+				"from_block": from,
+				"to_block":   to,
+			}).Error("Error fetching split contract logs")
+
+			return
+		}
+
+		// the range is scanned again on the next tick if a transaction can't be fetched, so
+		// its events are never published without their sender and recipients
+		txs := map[string]*transaction{}
+
+		for _, e := range events {
+			if _, ok := txs[e.TxHash]; ok {
+				continue
+			}
+
+			tx, err := g.getTransaction(ctx, node, e.TxHash)
+			if err != nil {
+				g.log.WithError(err).WithFields(logrus.Fields{
+					"node":     node.Name(),
+					"tx_hash":  e.TxHash,
+					"block":    e.BlockNumber,
+					"to_block": to,
+				}).Error("Error fetching split contract log transaction")
+
+				return
+			}
+
+			txs[e.TxHash] = tx
+		}
+
+		for _, e := range events {
+			g.publishContractEvent(e, txs[e.TxHash])
+		}
+
+		next := to + 1
+		g.nextBlock = &next
+		g.saveLogs(ctx)
+
+		g.metrics.UpdateLogsBlock(float64(to), []string{g.name, g.address})
+	}
+}
+
+// transaction holds the details of a transaction shared by the logs it emitted.
+type transaction struct {
+	sender     string
+	recipients *spl.Recipients
+}
+
+func (g *Group) getTransaction(ctx context.Context, node *execution.Node, hash string) (*transaction, error) {
+	tx, _, err := node.TransactionByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	if tx == nil {
+		return nil, fmt.Errorf("transaction %s not found", hash)
+	}
+
+	t := &transaction{}
+
+	t.sender, err = node.TransactionSender(ctx, tx)
+	if err != nil {
+		g.log.WithError(err).WithField("tx_hash", hash).Error("Error recovering transaction sender")
+	}
+
+	t.recipients, err = g.client.DecodeRecipients(g.contractABI, tx.Data())
+	if err != nil {
+		g.log.WithError(err).WithField("tx_hash", hash).Error("Error decoding split recipients")
+	}
+
+	return t, nil
+}
+
+func (g *Group) publishContractEvent(e *spl.ContractEvent, tx *transaction) {
+	evt := event.NewContractEvent(time.Now(), g.monitor, g.name, e.Name, g.address, e.TxHash, e.BlockNumber, tx.sender)
+	evt.Controller = e.Controller
+	evt.PreviousController = e.PreviousController
+	evt.Account = e.Account
+	evt.Distributor = e.Distributor
+
+	if e.Amount != nil {
+		evt.Amount = e.Amount.String()
+	}
+
+	if e.Name == spl.EventUpdateSplit && tx.recipients != nil {
+		g.setRecipients(e.TxHash, e.BlockNumber, tx.recipients)
+
+		evt.DistributorFee = tx.recipients.DistributorFee
+
+		for i, address := range tx.recipients.Accounts {
+			evt.Recipients = append(evt.Recipients, &event.ContractEventRecipient{
+				Address:    address,
+				Allocation: tx.recipients.PercentageAllocations[i],
+			})
+		}
+	}
+
+	g.metrics.IncContractEvents([]string{g.name, g.address, e.Name})
+
+	log := g.log.WithFields(logrus.Fields{
+		"split_address": g.address,
+		"event":         e.Name,
+		"tx_hash":       e.TxHash,
+		"block":         e.BlockNumber,
+		"sender":        tx.sender,
+	})
+
+	log.Info("Split contract event")
+
+	// every control transfer is already notified by the controller checks
+	if e.Name == spl.EventControlTransfer {
+		return
+	}
+
+	if err := g.publisher.Publish(evt); err != nil {
+		log.WithError(err).Error("Error publishing split contract event")
+	}
+}
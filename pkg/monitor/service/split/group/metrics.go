@@ -7,11 +7,16 @@ import (
 )
 
 type Metrics struct {
-	balance      *prometheus.GaugeVec
-	hashStable   *prometheus.GaugeVec
-	hashInitial  *prometheus.GaugeVec
-	hashRecovery *prometheus.GaugeVec
-	controller   *prometheus.GaugeVec
+	balance             *prometheus.GaugeVec
+	hashStable          *prometheus.GaugeVec
+	hashInitial         *prometheus.GaugeVec
+	hashRecovery        *prometheus.GaugeVec
+	controller          *prometheus.GaugeVec
+	potentialController *prometheus.GaugeVec
+	immutable           *prometheus.GaugeVec
+	controllerChanges   *prometheus.CounterVec
+	logsBlock           *prometheus.GaugeVec
+	contractEvents      *prometheus.CounterVec
 }
 
 var (
@@ -69,6 +74,51 @@ func GetMetricsInstance(namespace, monitor string) *Metrics {
 				},
 				[]string{"group", "source", "split_address", "expected_controller", "actual_controller", "type"},
 			),
+			potentialController: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace:   namespace,
+					Name:        "potential_controller",
+					Help:        "A control transfer of the split to the potential controller is pending.",
+					ConstLabels: constLabels,
+				},
+				[]string{"group", "source", "split_address", "expected_controller", "potential_controller"},
+			),
+			immutable: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace:   namespace,
+					Name:        "immutable",
+					Help:        "The controller of the split is the zero address so it can never be updated again.",
+					ConstLabels: constLabels,
+				},
+				[]string{"group", "source", "split_address"},
+			),
+			controllerChanges: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace:   namespace,
+					Name:        "controller_changes_total",
+					Help:        "The number of observed changes of the split controller.",
+					ConstLabels: constLabels,
+				},
+				[]string{"group", "split_address", "previous_controller", "controller"},
+			),
+			logsBlock: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace:   namespace,
+					Name:        "logs_block",
+					Help:        "The last block scanned for SplitMain logs of the split.",
+					ConstLabels: constLabels,
+				},
+				[]string{"group", "split_address"},
+			),
+			contractEvents: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace:   namespace,
+					Name:        "contract_events_total",
+					Help:        "The number of SplitMain events of the split.",
+					ConstLabels: constLabels,
+				},
+				[]string{"group", "split_address", "event"},
+			),
 		}
 
 		prometheus.MustRegister(metricsInstance.balance)
@@ -76,6 +126,11 @@ func GetMetricsInstance(namespace, monitor string) *Metrics {
 		prometheus.MustRegister(metricsInstance.hashInitial)
 		prometheus.MustRegister(metricsInstance.hashRecovery)
 		prometheus.MustRegister(metricsInstance.controller)
+		prometheus.MustRegister(metricsInstance.potentialController)
+		prometheus.MustRegister(metricsInstance.immutable)
+		prometheus.MustRegister(metricsInstance.controllerChanges)
+		prometheus.MustRegister(metricsInstance.logsBlock)
+		prometheus.MustRegister(metricsInstance.contractEvents)
 	})
 
 	return metricsInstance
@@ -100,3 +155,27 @@ func (m Metrics) UpdateHashRecovery(hash float64, labels []string) {
 func (m Metrics) UpdateController(controller float64, labels []string) {
 	m.controller.WithLabelValues(labels...).Set(controller)
 }
+
+func (m Metrics) UpdatePotentialController(pending float64, labels []string) {
+	m.potentialController.WithLabelValues(labels...).Set(pending)
+}
+
+func (m Metrics) DeletePotentialController(labels []string) {
+	m.potentialController.DeleteLabelValues(labels...)
+}
+
+func (m Metrics) UpdateImmutable(immutable float64, labels []string) {
+	m.immutable.WithLabelValues(labels...).Set(immutable)
+}
+
+func (m Metrics) IncControllerChanges(labels []string) {
+	m.controllerChanges.WithLabelValues(labels...).Inc()
+}
+
+func (m Metrics) UpdateLogsBlock(block float64, labels []string) {
+	m.logsBlock.WithLabelValues(labels...).Set(block)
+}
+
+func (m Metrics) IncContractEvents(labels []string) {
+	m.contractEvents.WithLabelValues(labels...).Inc()
+}
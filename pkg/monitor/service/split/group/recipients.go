@@ -0,0 +1,250 @@
+package group
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	spl "github.com/ethpandaops/splitoor/pkg/0xsplits/split"
+	"github.com/ethpandaops/splitoor/pkg/ethereum/execution"
+	event "github.com/ethpandaops/splitoor/pkg/monitor/event/split"
+	"github.com/ethpandaops/splitoor/pkg/monitor/status"
+	"github.com/sirupsen/logrus"
+)
+
+// recipients are the recipients of the split set by a transaction and the hash they produce.
+type recipients struct {
+	hash        string
+	txHash      string
+	blockNumber uint64
+	recipients  *spl.Recipients
+	changes     []*spl.RecipientChange
+}
+
+// setRecipients stores the recipients set by a transaction, eg. a scanned UpdateSplit log.
+func (g *Group) setRecipients(txHash string, blockNumber uint64, r *spl.Recipients) *recipients {
+	hash, err := r.Hash()
+	if err != nil {
+		g.log.WithError(err).WithField("tx_hash", txHash).Error("Error calculating recipients hash")
+
+		return nil
+	}
+
+	accounts := make([]string, len(g.accounts))
+	allocations := make([]uint32, len(g.accounts))
+
+	for i, account := range g.accounts {
+		accounts[i] = account.Address()
+		allocations[i] = account.Allocation()
+	}
+
+	rec := &recipients{
+		hash:        hex.EncodeToString(hash),
+		txHash:      txHash,
+		blockNumber: blockNumber,
+		recipients:  r,
+		changes:     r.Diff(accounts, allocations),
+	}
+
+	g.recipientsMu.Lock()
+	g.recipients = rec
+	g.recipientsMu.Unlock()
+
+	return rec
+}
+
+// getRecipients returns the stored recipients if they produce the hash.
+func (g *Group) getRecipients(hash string) *recipients {
+	g.recipientsMu.Lock()
+	defer g.recipientsMu.Unlock()
+
+	if g.recipients == nil || g.recipients.hash != hash {
+		return nil
+	}
+
+	return g.recipients
+}
+
+// reconstructRecipients returns the recipients that produce the unknown hash. If they aren't
+// known from the log scanner the CreateSplit and UpdateSplit logs of the split are searched
+// back from the head block and the recipients are decoded from the transaction input.
+func (g *Group) reconstructRecipients(ctx context.Context, node *execution.Node, hash string) *recipients {
+	if rec := g.getRecipients(hash); rec != nil {
+		return rec
+	}
+
+	g.recipientsSearchMu.Lock()
+	defer g.recipientsSearchMu.Unlock()
+
+	// only search once per hash unless the search failed, eg. a node error
+	if g.recipientsSearched == hash {
+		return g.getRecipients(hash)
+	}
+
+	hashBytes, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil
+	}
+
+	head, err := node.BlockNumber(ctx)
+	if err != nil {
+		g.log.WithError(err).WithField("node", node.Name()).Error("Error fetching block number")
+
+		return nil
+	}
+
+	lowest := uint64(0)
+	if lookback := g.logs.GetRecipientsLookback(); *head > lookback {
+		lowest = *head - lookback
+	}
+
+	var from uint64
+
+	log := g.log.WithFields(logrus.Fields{
+		"node":          node.Name(),
+		"split_address": g.address,
+		"actual_hash":   hash,
+	})
+
+	for to := *head; ; to = from - 1 {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		from = lowest
+		if to-lowest >= g.logs.GetMaxBlockRange() {
+			from = to - g.logs.GetMaxBlockRange() + 1
+		}
+
+		events, err := g.client.GetRecipientEvents(ctx, node, g.contractABI, from, to)
+		if err != nil {
+			log.WithError(err).WithFields(logrus.Fields{
+				"from_block": from,
+				"to_block":   to,
+			}).Error("Error fetching split recipient logs")
+
+			return nil
+		}
+
+		for i := len(events) - 1; i >= 0; i-- {
+			e := events[i]
+
+			tx, _, err := node.TransactionByHash(ctx, e.TxHash)
+			if err != nil || tx == nil {
+				// the hash isn't marked as searched so the search is retried on the next check
+				log.WithError(err).WithField("tx_hash", e.TxHash).Error("Error fetching split recipients transaction")
+
+				return nil
+			}
+
+			r, err := g.client.FindRecipients(g.contractABI, tx.Data(), hashBytes)
+			if err != nil {
+				log.WithError(err).WithField("tx_hash", e.TxHash).Error("Error decoding split recipients")
+
+				continue
+			}
+
+			if r != nil {
+				log.WithField("tx_hash", e.TxHash).Info("Reconstructed split recipients")
+
+				g.recipientsSearched = hash
+
+				return g.setRecipients(e.TxHash, e.BlockNumber, r)
+			}
+		}
+
+		if from == lowest {
+			break
+		}
+	}
+
+	g.recipientsSearched = hash
+
+	log.WithField("lookback", g.logs.GetRecipientsLookback()).Warn("Split recipients not found")
+
+	return nil
+}
+
+// newHashUnknownState creates a hash unknown event including the current recipients if known.
+func (g *Group) newHashUnknownState(actualHash string) *event.HashUnknownState {
+	evt := event.NewHashUnknownState(time.Now(), g.monitor, g.name, g.address, g.stableHash, actualHash)
+
+	rec := g.getRecipients(actualHash)
+	if rec == nil {
+		return evt
+	}
+
+	evt.DistributorFee = rec.recipients.DistributorFee
+	evt.RecipientsTxHash = rec.txHash
+	evt.Recipients = make([]*event.ContractEventRecipient, len(rec.recipients.Accounts))
+
+	for i, address := range rec.recipients.Accounts {
+		evt.Recipients[i] = &event.ContractEventRecipient{
+			Address:    address,
+			Allocation: rec.recipients.PercentageAllocations[i],
+		}
+	}
+
+	evt.Changes = make([]*event.RecipientChange, len(rec.changes))
+
+	for i, c := range rec.changes {
+		evt.Changes[i] = &event.RecipientChange{
+			Change:             c.Change,
+			Address:            c.Account,
+			ExpectedAllocation: c.ExpectedAllocation,
+			ActualAllocation:   c.ActualAllocation,
+		}
+	}
+
+	return evt
+}
+
+// recipientsStatus returns the stored recipients if a source sees the hash they produce.
+func (g *Group) recipientsStatus(sources map[string]*status.SplitSource) *status.SplitRecipients {
+	g.recipientsMu.Lock()
+	rec := g.recipients
+	g.recipientsMu.Unlock()
+
+	if rec == nil {
+		return nil
+	}
+
+	seen := false
+
+	for _, source := range sources {
+		if source.Hash == rec.hash && source.HashState == HashStateUnknown {
+			seen = true
+		}
+	}
+
+	if !seen {
+		return nil
+	}
+
+	s := &status.SplitRecipients{
+		Hash:           rec.hash,
+		TxHash:         rec.txHash,
+		BlockNumber:    rec.blockNumber,
+		Accounts:       make([]*status.SplitRecipient, len(rec.recipients.Accounts)),
+		DistributorFee: rec.recipients.DistributorFee,
+		Changes:        make([]*status.RecipientChange, len(rec.changes)),
+	}
+
+	for i, address := range rec.recipients.Accounts {
+		s.Accounts[i] = &status.SplitRecipient{
+			Address:    address,
+			Allocation: rec.recipients.PercentageAllocations[i],
+		}
+	}
+
+	for i, c := range rec.changes {
+		s.Changes[i] = &status.RecipientChange{
+			Change:             c.Change,
+			Address:            c.Account,
+			ExpectedAllocation: c.ExpectedAllocation,
+			ActualAllocation:   c.ActualAllocation,
+		}
+	}
+
+	return s
+}
@@ -2,6 +2,7 @@ package group
 
 import (
 	"sync"
+	"time"
 
 	"github.com/sirupsen/logrus"
 )
@@ -15,7 +16,12 @@ type State struct {
 
 type Split struct {
 	Hash       string
+	HashState  string
 	Controller string
+	// PotentialController is the controller of a pending control transfer, the zero address if there is none.
+	PotentialController string
+	Balance             string
+	UpdatedAt           time.Time
 }
 
 func NewState(log logrus.FieldLogger) *State {
@@ -40,6 +46,92 @@ func (s *State) UpdateSplit(source, hash, controller string) {
 	}
 }
 
+func (s *State) UpdateController(source, controller string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	split := s.getOrCreate(source)
+	split.Controller = controller
+	split.UpdatedAt = time.Now()
+}
+
+// Controller returns the last controller seen by the source, empty if there is none.
+func (s *State) Controller(source string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if split, exists := s.Sources[source]; exists {
+		return split.Controller
+	}
+
+	return ""
+}
+
+func (s *State) UpdatePotentialController(source, potentialController string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	split := s.getOrCreate(source)
+	split.PotentialController = potentialController
+	split.UpdatedAt = time.Now()
+}
+
+// PotentialController returns the last potential controller seen by the source, empty if there is none.
+func (s *State) PotentialController(source string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if split, exists := s.Sources[source]; exists {
+		return split.PotentialController
+	}
+
+	return ""
+}
+
+func (s *State) UpdateHash(source, hash, hashState string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	split := s.getOrCreate(source)
+	split.Hash = hash
+	split.HashState = hashState
+	split.UpdatedAt = time.Now()
+}
+
+func (s *State) UpdateBalance(source, balance string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	split := s.getOrCreate(source)
+	split.Balance = balance
+	split.UpdatedAt = time.Now()
+}
+
+// Copy returns a snapshot of the state per source.
+func (s *State) Copy() map[string]Split {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sources := make(map[string]Split, len(s.Sources))
+
+	for source, split := range s.Sources {
+		sources[source] = *split
+	}
+
+	return sources
+}
+
+// getOrCreate must be called with the lock held.
+func (s *State) getOrCreate(source string) *Split {
+	split, exists := s.Sources[source]
+	if !exists {
+		split = &Split{}
+		s.Sources[source] = split
+	}
+
+	return split
+}
+
 func (s *State) Merge(other *State) (changedSources []string) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
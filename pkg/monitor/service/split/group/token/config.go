@@ -0,0 +1,73 @@
+package token
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/0xsequence/ethkit/go-ethereum/common"
+)
+
+type Config struct {
+	Address string `yaml:"address"`
+	// MinBalance raises an event while the token balance of the split is below it, in whole tokens, eg. "1.5".
+	MinBalance string `yaml:"minBalance"`
+	// MaxBalance raises an event while the token balance of the split is above it, in whole tokens.
+	MaxBalance string `yaml:"maxBalance"`
+}
+
+func (c *Config) Validate() error {
+	if c == nil {
+		return fmt.Errorf("config is nil")
+	}
+
+	if !common.IsHexAddress(c.Address) {
+		return fmt.Errorf("address %q is not a valid address", c.Address)
+	}
+
+	minBalance, err := parseBalance(c.MinBalance)
+	if err != nil {
+		return fmt.Errorf("invalid minBalance: %w", err)
+	}
+
+	maxBalance, err := parseBalance(c.MaxBalance)
+	if err != nil {
+		return fmt.Errorf("invalid maxBalance: %w", err)
+	}
+
+	if minBalance != nil && maxBalance != nil && minBalance.Cmp(maxBalance) > 0 {
+		return fmt.Errorf("minBalance must not be greater than maxBalance")
+	}
+
+	return nil
+}
+
+// GetMinBalance returns the minimum balance in whole tokens, nil if not set.
+func (c *Config) GetMinBalance() *big.Rat {
+	balance, _ := parseBalance(c.MinBalance)
+
+	return balance
+}
+
+// GetMaxBalance returns the maximum balance in whole tokens, nil if not set.
+func (c *Config) GetMaxBalance() *big.Rat {
+	balance, _ := parseBalance(c.MaxBalance)
+
+	return balance
+}
+
+func parseBalance(value string) (*big.Rat, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	balance, ok := new(big.Rat).SetString(value)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a number", value)
+	}
+
+	if balance.Sign() < 0 {
+		return nil, fmt.Errorf("%q is negative", value)
+	}
+
+	return balance, nil
+}
@@ -0,0 +1,112 @@
+package token
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testToken = "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      *Config
+		expectError bool
+	}{
+		{
+			name: "valid config - no thresholds",
+			config: &Config{
+				Address: testToken,
+			},
+			expectError: false,
+		},
+		{
+			name: "valid config - thresholds",
+			config: &Config{
+				Address:    testToken,
+				MinBalance: "0.5",
+				MaxBalance: "1000",
+			},
+			expectError: false,
+		},
+		{
+			name:        "invalid config - nil",
+			config:      nil,
+			expectError: true,
+		},
+		{
+			name: "invalid config - invalid address",
+			config: &Config{
+				Address: "0x123",
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid config - invalid min balance",
+			config: &Config{
+				Address:    testToken,
+				MinBalance: "one",
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid config - negative max balance",
+			config: &Config{
+				Address:    testToken,
+				MaxBalance: "-1",
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid config - min balance greater than max balance",
+			config: &Config{
+				Address:    testToken,
+				MinBalance: "10",
+				MaxBalance: "1",
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.expectError {
+				assert.Error(t, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestConfigBalances(t *testing.T) {
+	config := &Config{Address: testToken, MinBalance: "1.5"}
+
+	assert.Equal(t, big.NewRat(3, 2), config.GetMinBalance())
+	assert.Nil(t, config.GetMaxBalance())
+}
+
+func TestFormatBalance(t *testing.T) {
+	tests := []struct {
+		name     string
+		balance  *big.Int
+		decimals uint8
+		want     string
+	}{
+		{name: "whole", balance: big.NewInt(2000000), decimals: 6, want: "2"},
+		{name: "fraction", balance: big.NewInt(1500000), decimals: 6, want: "1.5"},
+		{name: "small", balance: big.NewInt(1), decimals: 18, want: "0.000000000000000001"},
+		{name: "no decimals", balance: big.NewInt(42), decimals: 0, want: "42"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, FormatBalance(ToTokens(tt.balance, tt.decimals), tt.decimals))
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package token
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type Metrics struct {
+	info         *prometheus.GaugeVec
+	balance      *prometheus.GaugeVec
+	splitBalance *prometheus.GaugeVec
+}
+
+var (
+	metricsInstance *Metrics
+	once            sync.Once
+)
+
+func GetMetricsInstance(namespace, monitor string) *Metrics {
+	once.Do(func() {
+		constLabels := prometheus.Labels{"monitor": monitor}
+
+		metricsInstance = &Metrics{
+			info: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace:   namespace,
+					Name:        "info",
+					Help:        "The symbol and decimals of the token.",
+					ConstLabels: constLabels,
+				},
+				[]string{"group", "token", "symbol", "decimals"},
+			),
+			balance: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace:   namespace,
+					Name:        "balance",
+					Help:        "The token balance of the split in whole tokens.",
+					ConstLabels: constLabels,
+				},
+				[]string{"group", "source", "split_address", "token", "symbol"},
+			),
+			splitBalance: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace:   namespace,
+					Name:        "split_balance",
+					Help:        "The token balance of the account on the splits contract (not yet withdrawn) in whole tokens.",
+					ConstLabels: constLabels,
+				},
+				[]string{"group", "source", "address", "token", "symbol"},
+			),
+		}
+
+		prometheus.MustRegister(metricsInstance.info)
+		prometheus.MustRegister(metricsInstance.balance)
+		prometheus.MustRegister(metricsInstance.splitBalance)
+	})
+
+	return metricsInstance
+}
+
+func (m Metrics) UpdateInfo(labels []string) {
+	m.info.WithLabelValues(labels...).Set(1)
+}
+
+func (m Metrics) UpdateBalance(balance float64, labels []string) {
+	m.balance.WithLabelValues(labels...).Set(balance)
+}
+
+func (m Metrics) UpdateSplitBalance(splitBalance float64, labels []string) {
+	m.splitBalance.WithLabelValues(labels...).Set(splitBalance)
+}
@@ -0,0 +1,211 @@
+package token
+
+import (
+	"context"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0xsequence/ethkit/ethcoder"
+	spl "github.com/ethpandaops/splitoor/pkg/0xsplits/split"
+	"github.com/ethpandaops/splitoor/pkg/ethereum/execution"
+	"github.com/ethpandaops/splitoor/pkg/monitor/status"
+	"github.com/sirupsen/logrus"
+)
+
+// Token tracks the balances of an ERC-20 token of a split.
+type Token struct {
+	log          logrus.FieldLogger
+	monitor      string
+	group        string
+	splitAddress string
+	address      string
+	accounts     []string
+	minBalance   *big.Rat
+	maxBalance   *big.Rat
+
+	client   *spl.Client
+	contract *ethcoder.ABI
+
+	metrics *Metrics
+
+	// symbol and decimals are read once from the first node that returns them
+	symbol   string
+	decimals *uint8
+
+	sources map[string]*status.TokenSource
+	mu      sync.Mutex
+}
+
+func NewToken(log logrus.FieldLogger, monitor, group, splitAddress string, conf *Config, accounts []string) *Token {
+	return &Token{
+		log:          log.WithField("token", conf.Address),
+		monitor:      monitor,
+		group:        group,
+		splitAddress: splitAddress,
+		address:      conf.Address,
+		accounts:     accounts,
+		minBalance:   conf.GetMinBalance(),
+		maxBalance:   conf.GetMaxBalance(),
+		metrics:      GetMetricsInstance("splitoor_split_token", monitor),
+		sources:      make(map[string]*status.TokenSource),
+	}
+}
+
+func (t *Token) SetClient(client *spl.Client) {
+	t.client = client
+}
+
+func (t *Token) SetContract(contract *ethcoder.ABI) {
+	t.contract = contract
+}
+
+func (t *Token) Address() string {
+	return t.address
+}
+
+func (t *Token) Symbol() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.symbol
+}
+
+// MinBalance returns the minimum balance of the split in whole tokens, nil if not set.
+func (t *Token) MinBalance() *big.Rat {
+	return t.minBalance
+}
+
+// MaxBalance returns the maximum balance of the split in whole tokens, nil if not set.
+func (t *Token) MaxBalance() *big.Rat {
+	return t.maxBalance
+}
+
+// Update reads the token balances of the split and its accounts from the node and returns
+// the balance of the split in whole tokens with the token decimals.
+func (t *Token) Update(ctx context.Context, node *execution.Node) (*big.Rat, uint8, error) {
+	decimals, symbol, err := t.metadata(ctx, node)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	balance, err := node.ERC20BalanceOf(ctx, t.address, t.splitAddress)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	tokens := ToTokens(balance, decimals)
+	tokensFloat, _ := tokens.Float64()
+
+	t.metrics.UpdateBalance(tokensFloat, []string{t.group, node.Name(), t.splitAddress, t.address, symbol})
+
+	splitBalances := make(map[string]string, len(t.accounts))
+
+	if t.client != nil && t.contract != nil {
+		for _, account := range t.accounts {
+			splitBalance, err := t.client.GetERC20Balance(ctx, node, t.contract, account, t.address)
+			if err != nil {
+				t.log.WithError(err).WithFields(logrus.Fields{
+					"node":    node.Name(),
+					"account": account,
+				}).Error("Error fetching split account token balance")
+
+				continue
+			}
+
+			splitTokens, _ := ToTokens(splitBalance, decimals).Float64()
+
+			t.metrics.UpdateSplitBalance(splitTokens, []string{t.group, node.Name(), account, t.address, symbol})
+
+			splitBalances[account] = splitBalance.String()
+		}
+	}
+
+	t.mu.Lock()
+	t.sources[node.Name()] = &status.TokenSource{
+		Balance:       balance.String(),
+		SplitBalances: splitBalances,
+		UpdatedAt:     time.Now(),
+	}
+	t.mu.Unlock()
+
+	return tokens, decimals, nil
+}
+
+func (t *Token) metadata(ctx context.Context, node *execution.Node) (uint8, string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.decimals != nil {
+		return *t.decimals, t.symbol, nil
+	}
+
+	decimals, err := node.ERC20Decimals(ctx, t.address)
+	if err != nil {
+		return 0, "", err
+	}
+
+	symbol, err := node.ERC20Symbol(ctx, t.address)
+	if err != nil {
+		t.log.WithError(err).WithField("node", node.Name()).Warn("Error fetching token symbol")
+	}
+
+	t.decimals = &decimals
+	t.symbol = symbol
+
+	t.metrics.UpdateInfo([]string{t.group, t.address, symbol, strconv.Itoa(int(decimals))})
+
+	return decimals, symbol, nil
+}
+
+func (t *Token) Status() *status.SplitToken {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	sources := make(map[string]*status.TokenSource, len(t.sources))
+
+	for source, s := range t.sources {
+		src := *s
+		sources[source] = &src
+	}
+
+	s := &status.SplitToken{
+		Address: t.address,
+		Symbol:  t.symbol,
+		Sources: sources,
+	}
+
+	if t.decimals != nil {
+		s.Decimals = *t.decimals
+	}
+
+	if t.minBalance != nil {
+		s.MinBalance = FormatBalance(t.minBalance, s.Decimals)
+	}
+
+	if t.maxBalance != nil {
+		s.MaxBalance = FormatBalance(t.maxBalance, s.Decimals)
+	}
+
+	return s
+}
+
+// ToTokens converts a balance in the smallest unit of the token to whole tokens.
+func ToTokens(balance *big.Int, decimals uint8) *big.Rat {
+	unit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+
+	return new(big.Rat).SetFrac(balance, unit)
+}
+
+// FormatBalance formats a balance in whole tokens without trailing zeros.
+func FormatBalance(balance *big.Rat, decimals uint8) string {
+	formatted := balance.FloatString(int(decimals))
+
+	if strings.Contains(formatted, ".") {
+		formatted = strings.TrimRight(strings.TrimRight(formatted, "0"), ".")
+	}
+
+	return formatted
+}
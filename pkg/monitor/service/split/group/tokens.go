@@ -0,0 +1,165 @@
+package group
+
+import (
+	"context"
+	"math/big"
+	"slices"
+	"time"
+
+	mevent "github.com/ethpandaops/splitoor/pkg/monitor/event"
+	event "github.com/ethpandaops/splitoor/pkg/monitor/event/split"
+	"github.com/ethpandaops/splitoor/pkg/monitor/service/split/group/alert"
+	"github.com/ethpandaops/splitoor/pkg/monitor/service/split/group/token"
+	"github.com/ethpandaops/splitoor/pkg/monitor/status"
+	"github.com/ethpandaops/splitoor/pkg/monitor/store"
+	"github.com/sirupsen/logrus"
+)
+
+// tokenAlerts are the balance alerts of a token, nil if the threshold isn't configured.
+type tokenAlerts struct {
+	min *alert.TokenBalance
+	max *alert.TokenBalance
+}
+
+func newTokenAlerts(log logrus.FieldLogger, t *token.Token) *tokenAlerts {
+	alerts := &tokenAlerts{}
+
+	if t.MinBalance() != nil {
+		alerts.min = alert.NewTokenBalance(log, t.MinBalance(), false)
+	}
+
+	if t.MaxBalance() != nil {
+		alerts.max = alert.NewTokenBalance(log, t.MaxBalance(), true)
+	}
+
+	return alerts
+}
+
+func (g *Group) restoreTokenAlerts(ctx context.Context) {
+	for _, t := range g.tokens {
+		alerts := g.tokenAlerts[t.Address()]
+
+		if alerts.min != nil {
+			g.restoreTokenAlert(ctx, t.Address(), event.TokenBalanceMinType, alerts.min)
+		}
+
+		if alerts.max != nil {
+			g.restoreTokenAlert(ctx, t.Address(), event.TokenBalanceMaxType, alerts.max)
+		}
+	}
+}
+
+func (g *Group) checkTokens(ctx context.Context) {
+	if len(g.tokens) == 0 {
+		return
+	}
+
+	nodes := g.ethereumPool.GetHealthyExecutionNodes()
+
+	for _, t := range g.tokens {
+		balances := []*big.Rat{}
+
+		var decimals uint8
+
+		for _, node := range nodes {
+			if ctx.Err() != nil {
+				return
+			}
+
+			balance, d, err := t.Update(ctx, node)
+			if err != nil {
+				g.log.WithError(err).WithFields(logrus.Fields{
+					"node":  node.Name(),
+					"token": t.Address(),
+				}).Error("Error fetching token balance")
+
+				continue
+			}
+
+			balances = append(balances, balance)
+			decimals = d
+		}
+
+		if len(balances) == 0 {
+			continue
+		}
+
+		low, high := majorityBalances(balances)
+
+		alerts := g.tokenAlerts[t.Address()]
+
+		if alerts.min != nil {
+			g.checkTokenBalance(ctx, t, event.TokenBalanceMin, alerts.min, low, decimals)
+		}
+
+		if alerts.max != nil {
+			g.checkTokenBalance(ctx, t, event.TokenBalanceMax, alerts.max, high, decimals)
+		}
+	}
+}
+
+// majorityBalances returns the balances the thresholds are checked against so the alerts
+// only change when a majority of the nodes agree. low is the highest balance of the lowest
+// majority of the nodes, so it is below the minimum only if a majority is. high is the
+// lowest balance of the highest majority, so it is above the maximum only if a majority is.
+func majorityBalances(balances []*big.Rat) (low, high *big.Rat) {
+	sorted := slices.Clone(balances)
+	slices.SortFunc(sorted, func(a, b *big.Rat) int {
+		return a.Cmp(b)
+	})
+
+	return sorted[len(sorted)/2], sorted[(len(sorted)-1)/2]
+}
+
+func (g *Group) checkTokenBalance(ctx context.Context, t *token.Token, threshold string, a *alert.TokenBalance, balance *big.Rat, decimals uint8) {
+	alertType := event.TokenBalanceMinType
+	title := event.TokenBalanceMinTitle
+	limit := t.MinBalance()
+
+	if threshold == event.TokenBalanceMax {
+		alertType = event.TokenBalanceMaxType
+		title = event.TokenBalanceMaxTitle
+		limit = t.MaxBalance()
+	}
+
+	log := g.log.WithFields(logrus.Fields{
+		"split_address": g.address,
+		"token":         t.Address(),
+		"threshold":     threshold,
+		"balance":       token.FormatBalance(balance, decimals),
+		"limit":         token.FormatBalance(limit, decimals),
+	})
+
+	shouldAlert, shouldResolve := a.Update(balance)
+
+	g.notifyAlert(ctx, log, a, alertType, t.Address(), "token balance", title, shouldAlert, shouldResolve, func() mevent.Event {
+		return event.NewTokenBalance(time.Now(), g.monitor, g.name, g.address, t.Address(), t.Symbol(), threshold, token.FormatBalance(balance, decimals), token.FormatBalance(limit, decimals))
+	})
+}
+
+func (g *Group) restoreTokenAlert(ctx context.Context, tokenAddress, alertType string, a store.Alert) {
+	if err := store.RestoreAlert(ctx, g.store, store.AlertKey(g.monitor, g.name, alertType, tokenAddress), a); err != nil {
+		g.log.WithError(err).WithField("token", tokenAddress).WithField("alert_type", alertType).Error("Error restoring alert state")
+	}
+}
+
+// tokenAlertStatuses returns the state of the configured token balance alerts.
+func (g *Group) tokenAlertStatuses() []*status.Alert {
+	alerts := []*status.Alert{}
+
+	for _, t := range g.tokens {
+		a := g.tokenAlerts[t.Address()]
+
+		if a.min != nil {
+			state := a.min.State()
+			alerts = append(alerts, status.NewAlert(g.monitor, g.name, event.TokenBalanceMinType, t.Address(), state.Alerting, state.Since))
+		}
+
+		if a.max != nil {
+			state := a.max.State()
+			alerts = append(alerts, status.NewAlert(g.monitor, g.name, event.TokenBalanceMaxType, t.Address(), state.Alerting, state.Since))
+		}
+	}
+
+	return alerts
+}
@@ -0,0 +1,56 @@
+package group
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMajorityBalances(t *testing.T) {
+	tests := []struct {
+		name     string
+		balances []int64
+		wantLow  int64
+		wantHigh int64
+	}{
+		{
+			name:     "single node",
+			balances: []int64{5},
+			wantLow:  5,
+			wantHigh: 5,
+		},
+		{
+			name:     "two nodes disagree",
+			balances: []int64{10, 1},
+			wantLow:  10,
+			wantHigh: 1,
+		},
+		{
+			name:     "majority of three nodes",
+			balances: []int64{10, 1, 2},
+			wantLow:  2,
+			wantHigh: 2,
+		},
+		{
+			name:     "majority of four nodes",
+			balances: []int64{4, 1, 3, 2},
+			wantLow:  3,
+			wantHigh: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			balances := make([]*big.Rat, 0, len(tt.balances))
+			for _, b := range tt.balances {
+				balances = append(balances, big.NewRat(b, 1))
+			}
+
+			low, high := majorityBalances(balances)
+
+			assert.Equal(t, big.NewRat(tt.wantLow, 1), low)
+			assert.Equal(t, big.NewRat(tt.wantHigh, 1), high)
+		})
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/ethpandaops/splitoor/pkg/monitor/notifier"
 	"github.com/ethpandaops/splitoor/pkg/monitor/safe"
 	"github.com/ethpandaops/splitoor/pkg/monitor/service/split/group"
+	"github.com/ethpandaops/splitoor/pkg/monitor/status"
+	"github.com/ethpandaops/splitoor/pkg/monitor/store"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
@@ -21,11 +23,11 @@ type Service struct {
 	groups []*group.Group
 }
 
-func NewService(ctx context.Context, log logrus.FieldLogger, monitor string, config *Config, ethereumPool *ethereum.Pool, publisher *notifier.Publisher, safeClient safe.Client) (*Service, error) {
+func NewService(ctx context.Context, log logrus.FieldLogger, monitor string, config *Config, ethereumPool *ethereum.Pool, publisher *notifier.Publisher, safeClient safe.Client, st store.Store) (*Service, error) {
 	groups := make([]*group.Group, len(config.Groups))
 
 	for i, g := range config.Groups {
-		ng, err := group.NewGroup(ctx, log, monitor, &g, ethereumPool, publisher, safeClient)
+		ng, err := group.NewGroup(ctx, log, monitor, &g, ethereumPool, publisher, safeClient, st)
 		if err != nil {
 			return nil, err
 		}
@@ -80,3 +82,14 @@ func (s *Service) Stop(ctx context.Context) error {
 
 	return nil
 }
+
+// SplitGroups returns a status snapshot of every split group.
+func (s *Service) SplitGroups() []*status.SplitGroup {
+	groups := make([]*status.SplitGroup, len(s.groups))
+
+	for i, g := range s.groups {
+		groups[i] = g.Status()
+	}
+
+	return groups
+}
@@ -3,14 +3,16 @@ package alert
 import (
 	"sync"
 
+	"github.com/ethpandaops/splitoor/pkg/monitor/store"
 	"github.com/sirupsen/logrus"
 )
 
 type Balance struct {
+	store.AlertTracker
+
 	log        logrus.FieldLogger
 	minBalance uint64
 
-	alerting bool
 	balances []uint64
 	mu       sync.Mutex
 }
@@ -22,29 +24,14 @@ func NewBalance(log logrus.FieldLogger, minBalance uint64) *Balance {
 	}
 }
 
-func (b *Balance) Update(balances []uint64) (shouldAlert bool, balance *uint64) {
+func (b *Balance) Update(balances []uint64) (shouldAlert, shouldResolve bool, balance *uint64) {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
 	// check if any new balances trigger the alert
 	shouldBeAlerting, balance := b.check(balances)
 
-	// if already alerting, check if should still be alerting
-	if b.alerting {
-		// shouldn't re-alert if already alerting
-		shouldAlert = false
-		// stop alerting if no longer should be alerting
-		if !shouldBeAlerting {
-			b.alerting = false
-		}
-	} else {
-		shouldAlert = false
-
-		if shouldBeAlerting {
-			b.alerting = true
-			shouldAlert = true
-		}
-	}
+	shouldAlert, shouldResolve = b.Set(shouldBeAlerting)
 
 	b.balances = balances
 
@@ -60,3 +47,10 @@ func (b *Balance) check(balances []uint64) (shouldAlert bool, balance *uint64) {
 
 	return false, nil
 }
+
+// Check returns the first balance below the minimum without updating the alert, nil if there is none.
+func (b *Balance) Check(balances []uint64) *uint64 {
+	_, value := b.check(balances)
+
+	return value
+}
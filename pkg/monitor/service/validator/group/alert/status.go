@@ -3,14 +3,17 @@ package alert
 import (
 	"sync"
 
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/validator"
+	"github.com/ethpandaops/splitoor/pkg/monitor/store"
 	"github.com/sirupsen/logrus"
 )
 
 type Status struct {
+	store.AlertTracker
+
 	log        logrus.FieldLogger
 	allowedSet map[string]struct{}
 
-	alerting bool
 	statuses []string
 	mu       sync.Mutex
 }
@@ -27,28 +30,19 @@ func NewStatus(log logrus.FieldLogger, allowed []string) *Status {
 	}
 }
 
-func (s *Status) Update(statuses []string) (shouldAlert bool, alertingStatus *string) {
+func (s *Status) Update(statuses []string) (shouldAlert, shouldResolve bool, alertingStatus *string) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	// check if any new statuses trigger the alert
 	shouldBeAlerting, alertingStatus := s.check(statuses)
 
-	// if already alerting, check if should still be alerting
-	if s.alerting {
-		// shouldn't re-alert if already alerting
-		shouldAlert = false
-		// stop alerting if no longer should be alerting
-		if !shouldBeAlerting {
-			s.alerting = false
-		}
-	} else {
-		shouldAlert = false
+	shouldAlert, shouldResolve = s.Set(shouldBeAlerting)
 
-		if shouldBeAlerting {
-			s.alerting = true
-			shouldAlert = true
-		}
+	// the alert is escalated when it starts firing for a slashed status, or when an already
+	// firing validator is slashed since
+	if shouldBeAlerting && validator.IsSlashed(*alertingStatus) && s.Escalate() {
+		shouldAlert = true
 	}
 
 	s.statuses = statuses
@@ -56,12 +50,22 @@ func (s *Status) Update(statuses []string) (shouldAlert bool, alertingStatus *st
 	return
 }
 
+// check returns the first unexpected status, a slashed status before any other.
 func (s *Status) check(statuses []string) (shouldAlert bool, alertingStatus *string) {
 	for _, st := range statuses {
 		if _, exists := s.allowedSet[st]; !exists {
-			return true, &st
+			if alertingStatus == nil || (validator.IsSlashed(st) && !validator.IsSlashed(*alertingStatus)) {
+				alertingStatus = &st
+			}
 		}
 	}
 
-	return false, nil
+	return alertingStatus != nil, alertingStatus
+}
+
+// Check returns the first unexpected status without updating the alert, nil if there is none.
+func (s *Status) Check(statuses []string) *string {
+	_, value := s.check(statuses)
+
+	return value
 }
@@ -3,16 +3,18 @@ package alert
 import (
 	"sync"
 
+	"github.com/ethpandaops/splitoor/pkg/monitor/store"
 	"github.com/sirupsen/logrus"
 )
 
 type WithdrawalCredentials struct {
+	store.AlertTracker
+
 	log        logrus.FieldLogger
 	allowedSet map[int64]struct{}
 
-	alerting bool
-	codes    []int64
-	mu       sync.Mutex
+	codes []int64
+	mu    sync.Mutex
 }
 
 func NewWithdrawalCredentials(log logrus.FieldLogger, allowed []int64) *WithdrawalCredentials {
@@ -27,29 +29,14 @@ func NewWithdrawalCredentials(log logrus.FieldLogger, allowed []int64) *Withdraw
 	}
 }
 
-func (w *WithdrawalCredentials) Update(codes []int64) (shouldAlert bool, alertingCredential *int64) {
+func (w *WithdrawalCredentials) Update(codes []int64) (shouldAlert, shouldResolve bool, alertingCredential *int64) {
 	w.mu.Lock()
 	defer w.mu.Unlock()
 
 	// check if any new credentials trigger the alert
 	shouldBeAlerting, alertingCredential := w.check(codes)
 
-	// if already alerting, check if should still be alerting
-	if w.alerting {
-		// shouldn't re-alert if already alerting
-		shouldAlert = false
-		// stop alerting if no longer should be alerting
-		if !shouldBeAlerting {
-			w.alerting = false
-		}
-	} else {
-		shouldAlert = false
-
-		if shouldBeAlerting {
-			w.alerting = true
-			shouldAlert = true
-		}
-	}
+	shouldAlert, shouldResolve = w.Set(shouldBeAlerting)
 
 	w.codes = codes
 
@@ -65,3 +52,10 @@ func (w *WithdrawalCredentials) check(codes []int64) (shouldAlert bool, alerting
 
 	return false, nil
 }
+
+// Check returns the first unexpected withdrawal credentials code without updating the alert, nil if there is none.
+func (w *WithdrawalCredentials) Check(codes []int64) *int64 {
+	_, value := w.check(codes)
+
+	return value
+}
@@ -3,7 +3,9 @@ package group
 import (
 	"context"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -13,9 +15,12 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/ethpandaops/splitoor/pkg/ethereum"
 	"github.com/ethpandaops/splitoor/pkg/monitor/beaconchain"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
 	"github.com/ethpandaops/splitoor/pkg/monitor/event/validator"
 	"github.com/ethpandaops/splitoor/pkg/monitor/notifier"
 	"github.com/ethpandaops/splitoor/pkg/monitor/service/validator/group/alert"
+	"github.com/ethpandaops/splitoor/pkg/monitor/status"
+	"github.com/ethpandaops/splitoor/pkg/monitor/store"
 	"github.com/sirupsen/logrus"
 )
 
@@ -31,6 +36,7 @@ type Group struct {
 	monitor string
 
 	publisher *notifier.Publisher
+	store     store.Store
 
 	ethereumPool *ethereum.Pool
 	pubkeys      []phase0.BLSPubKey
@@ -42,13 +48,20 @@ type Group struct {
 	metrics *Metrics
 
 	validatorState              *State
+	lastTick                    time.Time
 	balanceAlerts               map[string]*alert.Balance
 	statusAlerts                map[string]*alert.Status
 	withdrawalCredentialsAlerts map[string]*alert.WithdrawalCredentials
 	mu                          sync.Mutex
+
+	// ticking is the running tick, Stop waits for it so its events are published before
+	// the publisher stops
+	ticking sync.WaitGroup
+	stopped bool
+	stopMu  sync.Mutex
 }
 
-func NewGroup(ctx context.Context, log logrus.FieldLogger, monitor string, conf *Config, ethereumPool *ethereum.Pool, bc beaconchain.Client, publisher *notifier.Publisher) (*Group, error) {
+func NewGroup(ctx context.Context, log logrus.FieldLogger, monitor string, conf *Config, ethereumPool *ethereum.Pool, bc beaconchain.Client, publisher *notifier.Publisher, st store.Store) (*Group, error) {
 	var chunks [][]string
 
 	if bc != nil {
@@ -80,6 +93,7 @@ func NewGroup(ctx context.Context, log logrus.FieldLogger, monitor string, conf
 		name:                        conf.Name,
 		monitor:                     monitor,
 		publisher:                   publisher,
+		store:                       st,
 		ethereumPool:                ethereumPool,
 		pubkeys:                     pubkeys,
 		beaconchain:                 bc,
@@ -93,6 +107,9 @@ func NewGroup(ctx context.Context, log logrus.FieldLogger, monitor string, conf
 }
 
 func (g *Group) Start(ctx context.Context) {
+	g.restoreState(ctx)
+	g.restoreAlerts(ctx)
+
 	g.tick(ctx)
 
 	for {
@@ -106,15 +123,102 @@ func (g *Group) Start(ctx context.Context) {
 }
 
 func (g *Group) Stop(ctx context.Context) error {
+	g.stopMu.Lock()
+	g.stopped = true
+	g.stopMu.Unlock()
+
+	g.ticking.Wait()
+
 	return nil
 }
 
+func (g *Group) Name() string {
+	return g.name
+}
+
+// Status returns a snapshot of every validator in the group as last seen by each source.
+func (g *Group) Status() *status.ValidatorGroup {
+	validators := make(map[string]map[string]*status.ValidatorSource)
+
+	for pubkey, sources := range g.validatorState.Copy() {
+		validators[pubkey] = make(map[string]*status.ValidatorSource, len(sources))
+
+		for source, v := range sources {
+			validators[pubkey][source] = &status.ValidatorSource{
+				Balance:                   v.Balance,
+				Status:                    string(v.Status),
+				WithdrawalCredentialsCode: v.WithdrawalCredentialsCode,
+			}
+		}
+	}
+
+	g.mu.Lock()
+	lastTick := g.lastTick
+	g.mu.Unlock()
+
+	return &status.ValidatorGroup{
+		Name:       g.name,
+		Validators: validators,
+		Alerts:     g.Alerts(),
+		LastTick:   status.TimePtr(lastTick),
+	}
+}
+
+// Alerts returns the state of every alert in the group, sorted by pubkey.
+func (g *Group) Alerts() []*status.Alert {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	pubkeys := make([]string, 0, len(g.balanceAlerts))
+
+	for pubkey := range g.balanceAlerts {
+		pubkeys = append(pubkeys, pubkey)
+	}
+
+	sort.Strings(pubkeys)
+
+	alerts := make([]*status.Alert, 0, len(pubkeys)*3)
+
+	for _, pubkey := range pubkeys {
+		alerts = append(alerts,
+			g.alertStatus(pubkey, validator.MinBalanceType, g.balanceAlerts[pubkey]),
+			g.alertStatus(pubkey, validator.StatusType, g.statusAlerts[pubkey]),
+			g.alertStatus(pubkey, validator.WithdrawalCredentialsType, g.withdrawalCredentialsAlerts[pubkey]),
+		)
+	}
+
+	return alerts
+}
+
+func (g *Group) alertStatus(pubkey, alertType string, a store.Alert) *status.Alert {
+	state := a.State()
+
+	return status.NewAlert(g.monitor, g.name, alertType, pubkey, state.Alerting, state.Since)
+}
+
 func (g *Group) tick(ctx context.Context) {
+	g.stopMu.Lock()
+
+	if g.stopped {
+		g.stopMu.Unlock()
+
+		return
+	}
+
+	g.ticking.Add(1)
+	g.stopMu.Unlock()
+
+	defer g.ticking.Done()
+
 	newState := NewState(g.log)
 
 	var wg sync.WaitGroup
 
+	queried := false
+
 	if g.beaconchain != nil && time.Since(g.beaconchainLastTick) > g.beaconchain.GetCheckInterval() {
+		queried = true
+
 		wg.Add(1)
 
 		go func() {
@@ -124,6 +228,8 @@ func (g *Group) tick(ctx context.Context) {
 	}
 
 	if g.ethereumPool != nil && g.ethereumPool.HasHealthyBeaconNodes() {
+		queried = true
+
 		wg.Add(1)
 
 		go func() {
@@ -136,9 +242,59 @@ func (g *Group) tick(ctx context.Context) {
 
 	g.mu.Lock()
 	changedPubkeys := g.validatorState.Merge(newState)
+
+	// between beaconcha.in check intervals there is nothing to query if no beacon nodes are configured
+	if len(newState.Validators) > 0 || (!queried && (g.ethereumPool == nil || !g.ethereumPool.HasBeaconNodes())) {
+		g.lastTick = time.Now()
+	}
 	g.mu.Unlock()
 
-	g.updateAlerts(changedPubkeys)
+	if len(changedPubkeys) > 0 {
+		g.saveState(ctx)
+	}
+
+	g.updateAlerts(ctx, changedPubkeys)
+}
+
+func (g *Group) stateKey() string {
+	return strings.Join([]string{"state", g.monitor, g.name}, "/")
+}
+
+// restoreState loads the last seen validators so a restart doesn't treat every validator
+// as changed.
+func (g *Group) restoreState(ctx context.Context) {
+	data, err := g.store.Get(ctx, g.stateKey())
+	if err != nil {
+		g.log.WithError(err).Error("Error restoring validator state")
+
+		return
+	}
+
+	if data == nil {
+		return
+	}
+
+	var validators map[string]map[string]Validator
+	if err := json.Unmarshal(data, &validators); err != nil {
+		g.log.WithError(err).Error("Error decoding validator state")
+
+		return
+	}
+
+	g.validatorState.Restore(validators)
+}
+
+func (g *Group) saveState(ctx context.Context) {
+	data, err := json.Marshal(g.validatorState.Copy())
+	if err != nil {
+		g.log.WithError(err).Error("Error encoding validator state")
+
+		return
+	}
+
+	if err := g.store.Set(ctx, g.stateKey(), data); err != nil {
+		g.log.WithError(err).Error("Error saving validator state")
+	}
 }
 
 func (g *Group) checkBeaconAPI(ctx context.Context, state *State) {
@@ -345,38 +501,51 @@ func (g *Group) updateValidatorBeaconAPI(data *v1.Validator, source string, stat
 	g.metrics.UpdateStatus(status, labels)
 }
 
-func (g *Group) updateAlerts(changedPubkeys []string) {
+func (g *Group) restoreAlerts(ctx context.Context) {
 	g.mu.Lock()
 	defer g.mu.Unlock()
 
-	for _, pubkey := range changedPubkeys {
-		if _, exists := g.balanceAlerts[pubkey]; !exists {
-			g.balanceAlerts[pubkey] = alert.NewBalance(g.log, MinBalance)
-		}
+	for _, pubkey := range g.pubkeys {
+		g.createAlerts(ctx, pubkey.String())
+	}
+}
 
-		if _, exists := g.statusAlerts[pubkey]; !exists {
-			g.statusAlerts[pubkey] = alert.NewStatus(g.log, ExpectedStatuses)
-		}
+// createAlerts creates the alerts for a pubkey if they don't exist yet and
+// restores any previously persisted state. Must be called with g.mu held.
+func (g *Group) createAlerts(ctx context.Context, pubkey string) {
+	if _, exists := g.balanceAlerts[pubkey]; !exists {
+		g.balanceAlerts[pubkey] = alert.NewBalance(g.log, MinBalance)
+		g.restoreAlert(ctx, pubkey, validator.MinBalanceType, g.balanceAlerts[pubkey])
+	}
 
-		if _, exists := g.withdrawalCredentialsAlerts[pubkey]; !exists {
-			g.withdrawalCredentialsAlerts[pubkey] = alert.NewWithdrawalCredentials(g.log, WithdrawalCredentialsCodes)
-		}
+	if _, exists := g.statusAlerts[pubkey]; !exists {
+		g.statusAlerts[pubkey] = alert.NewStatus(g.log, ExpectedStatuses)
+		g.restoreAlert(ctx, pubkey, validator.StatusType, g.statusAlerts[pubkey])
+	}
+
+	if _, exists := g.withdrawalCredentialsAlerts[pubkey]; !exists {
+		g.withdrawalCredentialsAlerts[pubkey] = alert.NewWithdrawalCredentials(g.log, WithdrawalCredentialsCodes)
+		g.restoreAlert(ctx, pubkey, validator.WithdrawalCredentialsType, g.withdrawalCredentialsAlerts[pubkey])
+	}
+}
+
+func (g *Group) updateAlerts(ctx context.Context, changedPubkeys []string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, pubkey := range changedPubkeys {
+		g.createAlerts(ctx, pubkey)
 
 		balanceAlert := g.balanceAlerts[pubkey]
 		statusAlert := g.statusAlerts[pubkey]
 		withdrawalCredentialsAlert := g.withdrawalCredentialsAlerts[pubkey]
 
-		balances := make([]uint64, 0, len(g.validatorState.Validators[pubkey].Sources))
-		statuses := make([]string, 0, len(g.validatorState.Validators[pubkey].Sources))
-		codes := make([]int64, 0, len(g.validatorState.Validators[pubkey].Sources))
+		balances, statuses, codes := g.validatorValues(pubkey)
 
-		for _, source := range g.validatorState.Validators[pubkey].Sources {
-			balances = append(balances, source.Balance)
-			statuses = append(statuses, string(source.Status))
-			codes = append(codes, source.WithdrawalCredentialsCode)
-		}
+		shouldAlertBalance, shouldResolveBalance, balance := balanceAlert.Update(balances)
+		if shouldAlertBalance {
+			g.saveAlert(ctx, pubkey, validator.MinBalanceType, balanceAlert)
 
-		if shouldAlert, balance := balanceAlert.Update(balances); shouldAlert {
 			g.log.WithField("balance", *balance).WithField("pubkey", pubkey).Warn("Alerting min balance")
 
 			if err := g.publisher.Publish(validator.NewMinBalance(time.Now(), *balance, pubkey, g.name, g.monitor)); err != nil {
@@ -384,7 +553,18 @@ func (g *Group) updateAlerts(changedPubkeys []string) {
 			}
 		}
 
-		if shouldAlert, alertingStatus := statusAlert.Update(statuses); shouldAlert {
+		if shouldResolveBalance {
+			g.saveAlert(ctx, pubkey, validator.MinBalanceType, balanceAlert)
+
+			g.log.WithField("pubkey", pubkey).Info("Resolving min balance")
+
+			g.publishResolved(pubkey, validator.MinBalanceType, validator.MinBalanceSeverity, "Validator has low balance", balanceAlert.Since())
+		}
+
+		shouldAlertStatus, shouldResolveStatus, alertingStatus := statusAlert.Update(statuses)
+		if shouldAlertStatus {
+			g.saveAlert(ctx, pubkey, validator.StatusType, statusAlert)
+
 			g.log.WithField("status", *alertingStatus).WithField("pubkey", pubkey).Warn("Alerting status")
 
 			if err := g.publisher.Publish(validator.NewStatus(time.Now(), *alertingStatus, pubkey, g.name, g.monitor)); err != nil {
@@ -392,13 +572,115 @@ func (g *Group) updateAlerts(changedPubkeys []string) {
 			}
 		}
 
-		if shouldAlert, alertingCredential := withdrawalCredentialsAlert.Update(codes); shouldAlert {
+		if shouldResolveStatus {
+			g.saveAlert(ctx, pubkey, validator.StatusType, statusAlert)
+
+			g.log.WithField("pubkey", pubkey).Info("Resolving status")
+
+			// the resolve follows the severity of the alert, which is critical for slashed validators
+			severity := validator.StatusSeverity
+			if statusAlert.Escalated() {
+				severity = event.SeverityCritical
+			}
+
+			g.publishResolved(pubkey, validator.StatusType, severity, "Validator has unexpectedly status", statusAlert.Since())
+		}
+
+		shouldAlertCredentials, shouldResolveCredentials, alertingCredential := withdrawalCredentialsAlert.Update(codes)
+		if shouldAlertCredentials {
+			g.saveAlert(ctx, pubkey, validator.WithdrawalCredentialsType, withdrawalCredentialsAlert)
+
 			g.log.WithField("credential", *alertingCredential).WithField("pubkey", pubkey).Warn("Alerting withdrawal credentials")
 
 			if err := g.publisher.Publish(validator.NewWithdrawalCredentials(time.Now(), *alertingCredential, pubkey, g.name, g.monitor)); err != nil {
 				g.log.WithError(err).WithField("pubkey", pubkey).WithField("credential", *alertingCredential).Error("Error publishing withdrawal credentials alert")
 			}
 		}
+
+		if shouldResolveCredentials {
+			g.saveAlert(ctx, pubkey, validator.WithdrawalCredentialsType, withdrawalCredentialsAlert)
+
+			g.log.WithField("pubkey", pubkey).Info("Resolving withdrawal credentials")
+
+			g.publishResolved(pubkey, validator.WithdrawalCredentialsType, validator.WithdrawalCredentialsSeverity, "Validator has unexpected withdrawal credentials type", withdrawalCredentialsAlert.Since())
+		}
+	}
+
+	g.remindAlerts(ctx)
+}
+
+// remindAlerts re-notifies every firing alert that is due a reminder. The alerts of
+// validators whose state didn't change aren't updated, but their condition persists so
+// they still need reminders. Must be called with g.mu held.
+func (g *Group) remindAlerts(ctx context.Context) {
+	for pubkey, balanceAlert := range g.balanceAlerts {
+		statusAlert := g.statusAlerts[pubkey]
+		withdrawalCredentialsAlert := g.withdrawalCredentialsAlerts[pubkey]
+
+		balances, statuses, codes := g.validatorValues(pubkey)
+
+		if balance := balanceAlert.Check(balances); balance != nil && balanceAlert.Remind(g.publisher.ReminderInterval(validator.MinBalanceType)) {
+			g.saveAlert(ctx, pubkey, validator.MinBalanceType, balanceAlert)
+
+			g.publishReminder(pubkey, validator.NewMinBalance(time.Now(), *balance, pubkey, g.name, g.monitor), balanceAlert.Since())
+		}
+
+		if alertingStatus := statusAlert.Check(statuses); alertingStatus != nil && statusAlert.Remind(g.publisher.ReminderInterval(validator.StatusType)) {
+			g.saveAlert(ctx, pubkey, validator.StatusType, statusAlert)
+
+			g.publishReminder(pubkey, validator.NewStatus(time.Now(), *alertingStatus, pubkey, g.name, g.monitor), statusAlert.Since())
+		}
+
+		if alertingCredential := withdrawalCredentialsAlert.Check(codes); alertingCredential != nil && withdrawalCredentialsAlert.Remind(g.publisher.ReminderInterval(validator.WithdrawalCredentialsType)) {
+			g.saveAlert(ctx, pubkey, validator.WithdrawalCredentialsType, withdrawalCredentialsAlert)
+
+			g.publishReminder(pubkey, validator.NewWithdrawalCredentials(time.Now(), *alertingCredential, pubkey, g.name, g.monitor), withdrawalCredentialsAlert.Since())
+		}
+	}
+}
+
+// validatorValues returns the balances, statuses and withdrawal credentials codes of the
+// validator as last seen by each source. Must be called with g.mu held.
+func (g *Group) validatorValues(pubkey string) (balances []uint64, statuses []string, codes []int64) {
+	validators, exists := g.validatorState.Validators[pubkey]
+	if !exists {
+		return nil, nil, nil
+	}
+
+	balances = make([]uint64, 0, len(validators.Sources))
+	statuses = make([]string, 0, len(validators.Sources))
+	codes = make([]int64, 0, len(validators.Sources))
+
+	for _, source := range validators.Sources {
+		balances = append(balances, source.Balance)
+		statuses = append(statuses, string(source.Status))
+		codes = append(codes, source.WithdrawalCredentialsCode)
+	}
+
+	return balances, statuses, codes
+}
+
+func (g *Group) restoreAlert(ctx context.Context, pubkey, alertType string, a store.Alert) {
+	if err := store.RestoreAlert(ctx, g.store, store.AlertKey(g.monitor, g.name, alertType, pubkey), a); err != nil {
+		g.log.WithError(err).WithField("pubkey", pubkey).WithField("alert_type", alertType).Error("Error restoring alert state")
+	}
+}
+
+func (g *Group) saveAlert(ctx context.Context, pubkey, alertType string, a store.Alert) {
+	if err := store.SaveAlert(ctx, g.store, store.AlertKey(g.monitor, g.name, alertType, pubkey), a); err != nil {
+		g.log.WithError(err).WithField("pubkey", pubkey).WithField("alert_type", alertType).Error("Error saving alert state")
+	}
+}
+
+func (g *Group) publishResolved(pubkey, alertType string, severity event.Severity, alertTitle string, since time.Time) {
+	if err := g.publisher.Publish(event.NewResolved(time.Now(), since, g.monitor, g.name, alertType, severity, alertTitle, pubkey)); err != nil {
+		g.log.WithError(err).WithField("pubkey", pubkey).WithField("alert_type", alertType).Error("Error publishing resolved alert")
+	}
+}
+
+func (g *Group) publishReminder(pubkey string, e event.Event, since time.Time) {
+	if err := g.publisher.Publish(event.NewReminder(time.Now(), since, e)); err != nil {
+		g.log.WithError(err).WithField("pubkey", pubkey).WithField("alert_type", e.GetType()).Error("Error publishing alert reminder")
 	}
 }
 
@@ -0,0 +1,111 @@
+package group
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/event"
+	"github.com/ethpandaops/splitoor/pkg/monitor/event/validator"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier"
+	"github.com/ethpandaops/splitoor/pkg/monitor/notifier/journal"
+	"github.com/ethpandaops/splitoor/pkg/monitor/store"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestGroup(t *testing.T, pubkey string, reminderInterval time.Duration) *Group {
+	t.Helper()
+
+	ctx := context.Background()
+	log := logrus.New()
+
+	st, err := store.NewStore(ctx, log, nil)
+	require.NoError(t, err)
+
+	publisher, err := notifier.NewPublisher(ctx, log, "monitor", notifier.Config{
+		Queue: notifier.QueueConfig{
+			Size:           10,
+			MaxAttempts:    1,
+			Timeout:        time.Second,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     time.Millisecond,
+		},
+		Reminders: notifier.ReminderConfig{Interval: reminderInterval},
+		Journal:   journal.Config{MaxEntries: 100},
+	}, st)
+	require.NoError(t, err)
+
+	g, err := NewGroup(ctx, log, "monitor", &Config{Name: "group", Pubkeys: []string{pubkey}}, nil, nil, publisher, st)
+	require.NoError(t, err)
+
+	return g
+}
+
+func TestUpdateAlertsRemindsUnchangedValidators(t *testing.T) {
+	ctx := context.Background()
+	pubkey := "0x" + strings.Repeat("ab", 48)
+
+	g := newTestGroup(t, pubkey, time.Hour)
+
+	g.validatorState.UpdateValidator("node-1", pubkey, 32e9, MetricsStatusExitedUnslashed, 1)
+
+	g.updateAlerts(ctx, []string{pubkey})
+
+	entries := g.publisher.Journal().List(journal.Query{})
+	require.Len(t, entries, 1)
+	assert.Equal(t, validator.StatusType, entries[0].Event.Type)
+
+	// the validator state stays the same, no reminder is due yet
+	g.updateAlerts(ctx, nil)
+	assert.Len(t, g.publisher.Journal().List(journal.Query{}), 1)
+
+	// the alert was last notified longer than the reminder interval ago
+	since := time.Now().Add(-3 * time.Hour)
+	g.statusAlerts[pubkey].Restore(store.AlertState{Alerting: true, Since: since, Notified: since})
+
+	g.updateAlerts(ctx, nil)
+
+	entries = g.publisher.Journal().List(journal.Query{})
+	require.Len(t, entries, 2)
+	assert.Equal(t, validator.StatusType+"_reminder", entries[0].Event.Type)
+	assert.Equal(t, pubkey, entries[0].Event.Subject)
+
+	// the reminder resets the interval
+	g.updateAlerts(ctx, nil)
+	assert.Len(t, g.publisher.Journal().List(journal.Query{}), 2)
+}
+
+func TestUpdateAlertsEscalatesSlashedValidators(t *testing.T) {
+	ctx := context.Background()
+	pubkey := "0x" + strings.Repeat("cd", 48)
+
+	g := newTestGroup(t, pubkey, 0)
+
+	g.validatorState.UpdateValidator("node-1", pubkey, 32e9, MetricsStatusExitingOnline, 1)
+	g.updateAlerts(ctx, []string{pubkey})
+
+	// the firing status alert is published again once the validator is slashed
+	g.validatorState.UpdateValidator("node-1", pubkey, 32e9, MetricsStatusSlashingOnline, 1)
+	g.updateAlerts(ctx, []string{pubkey})
+
+	g.validatorState.UpdateValidator("node-1", pubkey, 32e9, MetricsStatusExitedSlashed, 1)
+	g.updateAlerts(ctx, []string{pubkey})
+
+	entries := g.publisher.Journal().List(journal.Query{})
+	require.Len(t, entries, 2)
+	assert.Equal(t, validator.StatusType, entries[0].Event.Type)
+	assert.Equal(t, event.SeverityCritical, entries[0].Event.Severity)
+	assert.Equal(t, event.SeverityWarning, entries[1].Event.Severity)
+
+	// the resolve has the severity of the slashed alert
+	g.validatorState.UpdateValidator("node-1", pubkey, 32e9, MetricsStatusActiveOnline, 1)
+	g.updateAlerts(ctx, []string{pubkey})
+
+	entries = g.publisher.Journal().List(journal.Query{})
+	require.Len(t, entries, 3)
+	assert.True(t, entries[0].Event.Resolved)
+	assert.Equal(t, event.SeverityCritical, entries[0].Event.Severity)
+}
@@ -18,9 +18,9 @@ type Validators struct {
 }
 
 type Validator struct {
-	Balance                   uint64
-	Status                    MetricsStatus
-	WithdrawalCredentialsCode int64
+	Balance                   uint64        `json:"balance"`
+	Status                    MetricsStatus `json:"status"`
+	WithdrawalCredentialsCode int64         `json:"withdrawalCredentialsCode"`
 }
 
 func NewState(log logrus.FieldLogger) *State {
@@ -53,6 +53,43 @@ func (s *State) UpdateValidator(source, pubkey string, balance uint64, status Me
 	}
 }
 
+// Copy returns a snapshot of the state per pubkey per source.
+func (s *State) Copy() map[string]map[string]Validator {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	validators := make(map[string]map[string]Validator, len(s.Validators))
+
+	for pubkey, v := range s.Validators {
+		validators[pubkey] = make(map[string]Validator, len(v.Sources))
+
+		for source, validator := range v.Sources {
+			validators[pubkey][source] = *validator
+		}
+	}
+
+	return validators
+}
+
+// Restore replaces the state with a snapshot previously returned by Copy.
+func (s *State) Restore(validators map[string]map[string]Validator) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Validators = make(map[string]*Validators, len(validators))
+
+	for pubkey, sources := range validators {
+		s.Validators[pubkey] = &Validators{
+			Sources: make(map[string]*Validator, len(sources)),
+		}
+
+		for source, validator := range sources {
+			v := validator
+			s.Validators[pubkey].Sources[source] = &v
+		}
+	}
+}
+
 func (s *State) Merge(other *State) (changedPubkeys []string) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
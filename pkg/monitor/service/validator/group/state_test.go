@@ -0,0 +1,38 @@
+package group
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateRestore(t *testing.T) {
+	state := NewState(logrus.New())
+	state.UpdateValidator("node-1", "0x123", 32e9, MetricsStatusActiveOnline, 1)
+	state.UpdateValidator("beaconcha.in", "0x123", 31e9, MetricsStatusExitedUnslashed, 0)
+
+	data, err := json.Marshal(state.Copy())
+	require.NoError(t, err)
+
+	var validators map[string]map[string]Validator
+	require.NoError(t, json.Unmarshal(data, &validators))
+
+	restored := NewState(logrus.New())
+	restored.Restore(validators)
+
+	assert.Equal(t, state.Copy(), restored.Copy())
+
+	// the same validators seen again after a restart are not changed
+	next := NewState(logrus.New())
+	next.UpdateValidator("node-1", "0x123", 32e9, MetricsStatusActiveOnline, 1)
+	next.UpdateValidator("beaconcha.in", "0x123", 31e9, MetricsStatusExitedUnslashed, 0)
+
+	assert.Empty(t, restored.Merge(next))
+
+	next.UpdateValidator("node-1", "0x123", 30e9, MetricsStatusActiveOnline, 1)
+
+	assert.Equal(t, []string{"0x123"}, restored.Merge(next))
+}
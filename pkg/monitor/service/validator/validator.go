@@ -8,6 +8,8 @@ import (
 	"github.com/ethpandaops/splitoor/pkg/monitor/beaconchain"
 	"github.com/ethpandaops/splitoor/pkg/monitor/notifier"
 	"github.com/ethpandaops/splitoor/pkg/monitor/service/validator/group"
+	"github.com/ethpandaops/splitoor/pkg/monitor/status"
+	"github.com/ethpandaops/splitoor/pkg/monitor/store"
 	"github.com/sirupsen/logrus"
 )
 
@@ -20,7 +22,7 @@ type Service struct {
 	groups       []*group.Group
 }
 
-func NewService(ctx context.Context, log logrus.FieldLogger, monitor string, config *Config, ethereumPool *ethereum.Pool, publisher *notifier.Publisher, beaconchainClient beaconchain.Client) (*Service, error) {
+func NewService(ctx context.Context, log logrus.FieldLogger, monitor string, config *Config, ethereumPool *ethereum.Pool, publisher *notifier.Publisher, beaconchainClient beaconchain.Client, st store.Store) (*Service, error) {
 	if beaconchainClient == nil && !ethereumPool.HasBeaconNodes() {
 		return nil, fmt.Errorf("no beaconchain client or ethereum beacon nodes configured")
 	}
@@ -28,7 +30,7 @@ func NewService(ctx context.Context, log logrus.FieldLogger, monitor string, con
 	groups := make([]*group.Group, 0, len(config.Groups))
 
 	for _, g := range config.Groups {
-		ng, err := group.NewGroup(ctx, log, monitor, &g, ethereumPool, beaconchainClient, publisher)
+		ng, err := group.NewGroup(ctx, log, monitor, &g, ethereumPool, beaconchainClient, publisher, st)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create group client: %w", err)
 		}
@@ -67,3 +69,14 @@ func (s *Service) Stop(ctx context.Context) error {
 
 	return nil
 }
+
+// ValidatorGroups returns a status snapshot of every validator group.
+func (s *Service) ValidatorGroups() []*status.ValidatorGroup {
+	groups := make([]*status.ValidatorGroup, len(s.groups))
+
+	for i, g := range s.groups {
+		groups[i] = g.Status()
+	}
+
+	return groups
+}
@@ -0,0 +1,169 @@
+package status
+
+import "time"
+
+// SplitProvider provides the current status of the monitored splits.
+type SplitProvider interface {
+	SplitGroups() []*SplitGroup
+}
+
+// ValidatorProvider provides the current status of the monitored validators.
+type ValidatorProvider interface {
+	ValidatorGroups() []*ValidatorGroup
+}
+
+// Alert is the current state of a single alert.
+type Alert struct {
+	Monitor  string     `json:"monitor"`
+	Group    string     `json:"group"`
+	Type     string     `json:"type"`
+	Subject  string     `json:"subject"`
+	Alerting bool       `json:"alerting"`
+	Since    *time.Time `json:"since,omitempty"`
+}
+
+// NewAlert creates an alert status, since is omitted if it was never set.
+func NewAlert(monitor, group, alertType, subject string, alerting bool, since time.Time) *Alert {
+	return &Alert{
+		Monitor:  monitor,
+		Group:    group,
+		Type:     alertType,
+		Subject:  subject,
+		Alerting: alerting,
+		Since:    TimePtr(since),
+	}
+}
+
+// TimePtr returns nil for the zero time so it is omitted from responses.
+func TimePtr(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &t
+}
+
+// SplitGroup is the current state of a monitored split.
+type SplitGroup struct {
+	Name            string                  `json:"name"`
+	Address         string                  `json:"address"`
+	RecoveryAddress string                  `json:"recoveryAddress"`
+	Contract        string                  `json:"contract"`
+	Controller      *Controller             `json:"controller"`
+	StableHash      string                  `json:"stableHash"`
+	InitialHash     string                  `json:"initialHash"`
+	RecoveryHash    string                  `json:"recoveryHash"`
+	Sources         map[string]*SplitSource `json:"sources"`
+	Accounts        []*SplitAccount         `json:"accounts"`
+	Tokens          []*SplitToken           `json:"tokens,omitempty"`
+	// Recipients are the current recipients when the split hash is unknown, if they could be reconstructed.
+	Recipients *SplitRecipients `json:"recipients,omitempty"`
+	Alerts     []*Alert         `json:"alerts"`
+	LastTick   *time.Time       `json:"lastTick,omitempty"`
+}
+
+// Controller is the configured controller of a split.
+type Controller struct {
+	Type    string `json:"type"`
+	Address string `json:"address"`
+	Safe    *Safe  `json:"safe,omitempty"`
+}
+
+// SplitSource is the state of a split as seen by a single execution node.
+type SplitSource struct {
+	Controller string `json:"controller"`
+	// PotentialController is the controller of a pending control transfer, the zero address if there is none.
+	PotentialController string    `json:"potentialController,omitempty"`
+	Hash                string    `json:"hash"`
+	HashState           string    `json:"hashState"`
+	Balance             string    `json:"balance"`
+	UpdatedAt           time.Time `json:"updatedAt"`
+}
+
+// SplitAccount is the state of a split recipient.
+type SplitAccount struct {
+	Address    string                    `json:"address"`
+	Allocation uint32                    `json:"allocation"`
+	Sources    map[string]*AccountSource `json:"sources"`
+}
+
+// SplitToken is the state of an ERC-20 token of a split.
+type SplitToken struct {
+	Address  string `json:"address"`
+	Symbol   string `json:"symbol"`
+	Decimals uint8  `json:"decimals"`
+	// MinBalance and MaxBalance are the configured thresholds in whole tokens.
+	MinBalance string                  `json:"minBalance,omitempty"`
+	MaxBalance string                  `json:"maxBalance,omitempty"`
+	Sources    map[string]*TokenSource `json:"sources"`
+}
+
+// TokenSource is the state of a token as seen by a single execution node, balances are
+// in the smallest unit of the token.
+type TokenSource struct {
+	Balance string `json:"balance"`
+	// SplitBalances are the balances of the accounts on the splits contract (not yet withdrawn).
+	SplitBalances map[string]string `json:"splitBalances"`
+	UpdatedAt     time.Time         `json:"updatedAt"`
+}
+
+// SplitRecipients are the current recipients of a split, reconstructed from the transaction that set them.
+type SplitRecipients struct {
+	Hash           string             `json:"hash"`
+	TxHash         string             `json:"txHash"`
+	BlockNumber    uint64             `json:"blockNumber"`
+	Accounts       []*SplitRecipient  `json:"accounts"`
+	DistributorFee uint32             `json:"distributorFee"`
+	Changes        []*RecipientChange `json:"changes"`
+}
+
+// SplitRecipient is a recipient of a split and its allocation in millionths.
+type SplitRecipient struct {
+	Address    string `json:"address"`
+	Allocation uint32 `json:"allocation"`
+}
+
+// RecipientChange is a difference between the configured accounts and the recipients of a split.
+type RecipientChange struct {
+	// Change is added, removed or changed.
+	Change             string `json:"change"`
+	Address            string `json:"address"`
+	ExpectedAllocation uint32 `json:"expectedAllocation"`
+	ActualAllocation   uint32 `json:"actualAllocation"`
+}
+
+// AccountSource is the state of a split recipient as seen by a single execution node.
+type AccountSource struct {
+	Balance      string    `json:"balance"`
+	SplitBalance string    `json:"splitBalance"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+// Safe is the result of the latest Safe transaction queue analysis.
+type Safe struct {
+	Address                  string    `json:"address"`
+	SignersMatch             bool      `json:"signersMatch"`
+	QueueSize                int       `json:"queueSize"`
+	RecoveryTransaction      string    `json:"recoveryTransaction"`
+	RecoveryTransactionValid bool      `json:"recoveryTransactionValid"`
+	RecoveryTransactionError string    `json:"recoveryTransactionError,omitempty"`
+	RecoveryTransactionNext  bool      `json:"recoveryTransactionNext"`
+	Confirmations            int       `json:"confirmations"`
+	ExpectedConfirmations    int       `json:"expectedConfirmations"`
+	UpdatedAt                time.Time `json:"updatedAt"`
+}
+
+// ValidatorGroup is the current state of a monitored validator group.
+type ValidatorGroup struct {
+	Name       string                                 `json:"name"`
+	Validators map[string]map[string]*ValidatorSource `json:"validators"`
+	Alerts     []*Alert                               `json:"alerts"`
+	LastTick   *time.Time                             `json:"lastTick,omitempty"`
+}
+
+// ValidatorSource is the state of a validator as seen by a single source.
+type ValidatorSource struct {
+	Balance                   uint64 `json:"balance"`
+	Status                    string `json:"status"`
+	WithdrawalCredentialsCode int64  `json:"withdrawalCredentialsCode"`
+}
@@ -0,0 +1,181 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+)
+
+// AlertState is the persisted state of a single alert.
+type AlertState struct {
+	Alerting bool      `json:"alerting"`
+	Since    time.Time `json:"since"`
+	// Notified is when a notification was last published for the alert, used for reminders.
+	Notified time.Time `json:"notified"`
+	// Escalated is set while the alert fires at a raised severity, eg. for a slashed validator.
+	Escalated bool `json:"escalated,omitempty"`
+}
+
+// Alert is implemented by alerts whose state can be persisted.
+type Alert interface {
+	State() AlertState
+	Restore(state AlertState)
+}
+
+// AlertTracker holds the state every alert keeps, alerts embed it so they are persisted
+// and reminded the same way.
+type AlertTracker struct {
+	mu        sync.Mutex
+	alerting  bool
+	since     time.Time
+	notified  time.Time
+	escalated bool
+}
+
+// Set updates whether the alert should be firing. shouldAlert is true when the alert starts
+// firing and shouldResolve when it stops.
+func (t *AlertTracker) Set(alerting bool) (shouldAlert, shouldResolve bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	switch {
+	case t.alerting && !alerting:
+		t.alerting = false
+
+		return false, true
+	case !t.alerting && alerting:
+		t.alerting = true
+		t.since = time.Now()
+		t.notified = t.since
+		t.escalated = false
+
+		return true, false
+	}
+
+	return false, false
+}
+
+// Escalate raises the severity of the firing alert, it returns true if the alert wasn't
+// escalated yet and should be notified again.
+func (t *AlertTracker) Escalate() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.alerting || t.escalated {
+		return false
+	}
+
+	t.escalated = true
+	t.notified = time.Now()
+
+	return true
+}
+
+// Escalated returns true if the current (or most recently resolved) alert was escalated.
+func (t *AlertTracker) Escalated() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.escalated
+}
+
+// Since returns when the current (or most recently resolved) alert started.
+func (t *AlertTracker) Since() time.Time {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.since
+}
+
+func (t *AlertTracker) State() AlertState {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return AlertState{
+		Alerting:  t.alerting,
+		Since:     t.since,
+		Notified:  t.notified,
+		Escalated: t.escalated,
+	}
+}
+
+func (t *AlertTracker) Restore(state AlertState) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.alerting = state.Alerting
+	t.since = state.Since
+	t.notified = state.Notified
+	t.escalated = state.Escalated
+}
+
+// Remind returns true if the alert is still alerting and was last notified at least
+// interval ago, an interval of 0 disables reminders.
+func (t *AlertTracker) Remind(interval time.Duration) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.alerting || interval <= 0 {
+		return false
+	}
+
+	notified := t.notified
+	if notified.IsZero() {
+		notified = t.since
+	}
+
+	if time.Since(notified) < interval {
+		return false
+	}
+
+	t.notified = time.Now()
+
+	return true
+}
+
+// AlertKey builds the key an alert is stored under. Subject is optional and
+// only required when a group holds more than one alert of the same type, eg.
+// one per validator pubkey.
+func AlertKey(monitor, group, alertType, subject string) string {
+	parts := []string{"alert", monitor, group, alertType}
+
+	if subject != "" {
+		parts = append(parts, subject)
+	}
+
+	return strings.Join(parts, "/")
+}
+
+// RestoreAlert loads the state stored under key into the alert. Missing keys
+// leave the alert untouched.
+func RestoreAlert(ctx context.Context, s Store, key string, a Alert) error {
+	data, err := s.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	if data == nil {
+		return nil
+	}
+
+	var state AlertState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return err
+	}
+
+	a.Restore(state)
+
+	return nil
+}
+
+// SaveAlert persists the current state of the alert under key.
+func SaveAlert(ctx context.Context, s Store, key string, a Alert) error {
+	data, err := json.Marshal(a.State())
+	if err != nil {
+		return err
+	}
+
+	return s.Set(ctx, key, data)
+}
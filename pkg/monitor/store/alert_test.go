@@ -0,0 +1,149 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/store"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockAlert struct {
+	state store.AlertState
+}
+
+func (m *mockAlert) State() store.AlertState {
+	return m.state
+}
+
+func (m *mockAlert) Restore(state store.AlertState) {
+	m.state = state
+}
+
+func TestAlertKey(t *testing.T) {
+	assert.Equal(t, "alert/monitor/group/split_controller", store.AlertKey("monitor", "group", "split_controller", ""))
+	assert.Equal(t, "alert/monitor/group/validator_status/0x123", store.AlertKey("monitor", "group", "validator_status", "0x123"))
+}
+
+func TestSaveAndRestoreAlert(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := store.NewStore(ctx, logrus.New(), nil)
+	require.NoError(t, err)
+	require.NoError(t, s.Start(ctx))
+
+	key := store.AlertKey("monitor", "group", "split_controller", "")
+	since := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	// restoring a missing key leaves the alert untouched
+	restored := &mockAlert{}
+	require.NoError(t, store.RestoreAlert(ctx, s, key, restored))
+	assert.False(t, restored.state.Alerting)
+	assert.True(t, restored.state.Since.IsZero())
+
+	notified := since.Add(time.Hour)
+
+	saved := &mockAlert{state: store.AlertState{Alerting: true, Since: since, Notified: notified}}
+	require.NoError(t, store.SaveAlert(ctx, s, key, saved))
+
+	require.NoError(t, store.RestoreAlert(ctx, s, key, restored))
+	assert.True(t, restored.state.Alerting)
+	assert.True(t, since.Equal(restored.state.Since))
+	assert.True(t, notified.Equal(restored.state.Notified))
+}
+
+func TestAlertTracker(t *testing.T) {
+	var tracker store.AlertTracker
+
+	shouldAlert, shouldResolve := tracker.Set(false)
+	assert.False(t, shouldAlert)
+	assert.False(t, shouldResolve)
+
+	shouldAlert, shouldResolve = tracker.Set(true)
+	assert.True(t, shouldAlert)
+	assert.False(t, shouldResolve)
+	assert.False(t, tracker.Since().IsZero())
+
+	// already alerting, nothing to notify
+	shouldAlert, shouldResolve = tracker.Set(true)
+	assert.False(t, shouldAlert)
+	assert.False(t, shouldResolve)
+
+	assert.True(t, tracker.Escalate())
+	assert.False(t, tracker.Escalate())
+	assert.True(t, tracker.Escalated())
+
+	assert.False(t, tracker.Remind(0))
+	assert.False(t, tracker.Remind(time.Hour))
+
+	shouldAlert, shouldResolve = tracker.Set(false)
+	assert.False(t, shouldAlert)
+	assert.True(t, shouldResolve)
+	assert.True(t, tracker.Escalated(), "resolved alert keeps its escalation")
+	assert.False(t, tracker.Escalate())
+
+	// restoring an alert notified long ago reminds straight away
+	since := time.Now().Add(-2 * time.Hour)
+	tracker.Restore(store.AlertState{Alerting: true, Since: since, Notified: since})
+	assert.False(t, tracker.Escalated())
+	assert.True(t, tracker.Remind(time.Hour))
+	assert.False(t, tracker.Remind(time.Hour))
+	assert.True(t, since.Equal(tracker.State().Since))
+}
+
+func TestNewStore(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      *store.Config
+		wantType    string
+		expectError bool
+	}{
+		{
+			name:     "nil config",
+			config:   nil,
+			wantType: "memory",
+		},
+		{
+			name:     "memory",
+			config:   &store.Config{StoreType: store.StoreTypeMemory},
+			wantType: "memory",
+		},
+		{
+			name:     "file",
+			config:   &store.Config{StoreType: store.StoreTypeFile},
+			wantType: "file",
+		},
+		{
+			name:     "bolt",
+			config:   &store.Config{StoreType: store.StoreTypeBolt},
+			wantType: "bolt",
+		},
+		{
+			name:     "sqlite",
+			config:   &store.Config{StoreType: store.StoreTypeSQLite},
+			wantType: "sqlite",
+		},
+		{
+			name:        "unknown",
+			config:      &store.Config{StoreType: store.StoreTypeUnknown},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := store.NewStore(context.Background(), logrus.New(), tt.config)
+			if tt.expectError {
+				assert.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantType, s.GetType())
+		})
+	}
+}
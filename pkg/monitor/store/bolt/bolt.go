@@ -0,0 +1,102 @@
+package bolt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	bbolt "go.etcd.io/bbolt"
+)
+
+const StoreType = "bolt"
+
+// Bolt stores state in an embedded BoltDB database.
+type Bolt struct {
+	log    logrus.FieldLogger
+	config *Config
+	db     *bbolt.DB
+}
+
+func New(ctx context.Context, log logrus.FieldLogger, config *Config) (*Bolt, error) {
+	return &Bolt{
+		log:    log.WithField("store", StoreType).WithField("path", config.Path),
+		config: config,
+	}, nil
+}
+
+func (b *Bolt) Start(ctx context.Context) error {
+	db, err := bbolt.Open(b.config.Path, 0o600, &bbolt.Options{Timeout: b.config.Timeout})
+	if err != nil {
+		return fmt.Errorf("failed to open bolt database: %w", err)
+	}
+
+	if err := db.Update(func(tx *bbolt.Tx) error {
+		_, bErr := tx.CreateBucketIfNotExists([]byte(b.config.Bucket))
+
+		return bErr
+	}); err != nil {
+		db.Close()
+
+		return fmt.Errorf("failed to create bolt bucket: %w", err)
+	}
+
+	b.db = db
+
+	b.log.Info("Opened bolt database")
+
+	return nil
+}
+
+func (b *Bolt) Stop(ctx context.Context) error {
+	if b.db == nil {
+		return nil
+	}
+
+	return b.db.Close()
+}
+
+func (b *Bolt) GetType() string {
+	return StoreType
+}
+
+func (b *Bolt) Get(ctx context.Context, key string) ([]byte, error) {
+	if b.db == nil {
+		return nil, errors.New("bolt database is not open")
+	}
+
+	var value []byte
+
+	err := b.db.View(func(tx *bbolt.Tx) error {
+		if v := tx.Bucket([]byte(b.config.Bucket)).Get([]byte(key)); v != nil {
+			value = append([]byte(nil), v...)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (b *Bolt) Set(ctx context.Context, key string, value []byte) error {
+	if b.db == nil {
+		return errors.New("bolt database is not open")
+	}
+
+	return b.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(b.config.Bucket)).Put([]byte(key), value)
+	})
+}
+
+func (b *Bolt) Delete(ctx context.Context, key string) error {
+	if b.db == nil {
+		return errors.New("bolt database is not open")
+	}
+
+	return b.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(b.config.Bucket)).Delete([]byte(key))
+	})
+}
@@ -0,0 +1,59 @@
+package bolt_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/store/bolt"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoltPersistsAcrossRestarts(t *testing.T) {
+	ctx := context.Background()
+	config := &bolt.Config{
+		Path:    filepath.Join(t.TempDir(), "state.db"),
+		Bucket:  "splitoor",
+		Timeout: time.Second,
+	}
+
+	b, err := bolt.New(ctx, logrus.New(), config)
+	require.NoError(t, err)
+
+	_, err = b.Get(ctx, "key")
+	assert.Error(t, err, "should error before the database is opened")
+
+	require.NoError(t, b.Start(ctx))
+
+	value, err := b.Get(ctx, "missing")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	require.NoError(t, b.Set(ctx, "key1", []byte("value1")))
+	require.NoError(t, b.Set(ctx, "key2", []byte("value2")))
+	require.NoError(t, b.Delete(ctx, "key2"))
+	require.NoError(t, b.Stop(ctx))
+
+	reopened, err := bolt.New(ctx, logrus.New(), config)
+	require.NoError(t, err)
+	require.NoError(t, reopened.Start(ctx))
+
+	defer reopened.Stop(ctx)
+
+	value, err = reopened.Get(ctx, "key1")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value1"), value)
+
+	value, err = reopened.Get(ctx, "key2")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func TestConfigValidate(t *testing.T) {
+	assert.NoError(t, (&bolt.Config{Path: "state.db", Bucket: "splitoor"}).Validate())
+	assert.Error(t, (&bolt.Config{Bucket: "splitoor"}).Validate())
+	assert.Error(t, (&bolt.Config{Path: "state.db"}).Validate())
+}
@@ -0,0 +1,27 @@
+package bolt
+
+import (
+	"errors"
+	"time"
+)
+
+type Config struct {
+	// Path is the BoltDB database file.
+	Path string `yaml:"path" default:"splitoor.db"`
+	// Bucket is the bucket state is stored in.
+	Bucket string `yaml:"bucket" default:"splitoor"`
+	// Timeout is how long to wait for the database file lock.
+	Timeout time.Duration `yaml:"timeout" default:"10s"`
+}
+
+func (c *Config) Validate() error {
+	if c.Path == "" {
+		return errors.New("path is required")
+	}
+
+	if c.Bucket == "" {
+		return errors.New("bucket is required")
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package store
+
+import "errors"
+
+type Config struct {
+	StoreType StoreType   `yaml:"type" default:"memory"`
+	Config    *RawMessage `yaml:"config"`
+}
+
+type StoreType string
+
+const (
+	StoreTypeUnknown StoreType = "unknown"
+	StoreTypeMemory  StoreType = "memory"
+	StoreTypeFile    StoreType = "file"
+	StoreTypeBolt    StoreType = "bolt"
+	StoreTypeSQLite  StoreType = "sqlite"
+)
+
+func (c *Config) Validate() error {
+	switch c.StoreType {
+	case "", StoreTypeMemory, StoreTypeFile, StoreTypeBolt, StoreTypeSQLite:
+		return nil
+	case StoreTypeUnknown:
+		return errors.New("store type is required")
+	}
+
+	return errors.New("store type is not supported")
+}
@@ -0,0 +1,76 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      *Config
+		expectError bool
+	}{
+		{
+			name:        "valid config - empty type",
+			config:      &Config{},
+			expectError: false,
+		},
+		{
+			name: "valid config - memory",
+			config: &Config{
+				StoreType: StoreTypeMemory,
+			},
+			expectError: false,
+		},
+		{
+			name: "valid config - file",
+			config: &Config{
+				StoreType: StoreTypeFile,
+			},
+			expectError: false,
+		},
+		{
+			name: "valid config - bolt",
+			config: &Config{
+				StoreType: StoreTypeBolt,
+			},
+			expectError: false,
+		},
+		{
+			name: "valid config - sqlite",
+			config: &Config{
+				StoreType: StoreTypeSQLite,
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid config - unknown type",
+			config: &Config{
+				StoreType: StoreTypeUnknown,
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid config - unsupported type",
+			config: &Config{
+				StoreType: "redis",
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.expectError {
+				assert.Error(t, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
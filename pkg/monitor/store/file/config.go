@@ -0,0 +1,16 @@
+package file
+
+import "errors"
+
+type Config struct {
+	// Path is the JSON file the state is written to.
+	Path string `yaml:"path" default:"splitoor-state.json"`
+}
+
+func (c *Config) Validate() error {
+	if c.Path == "" {
+		return errors.New("path is required")
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+const StoreType = "file"
+
+// File keeps state in memory and writes it to a single JSON file on every change.
+type File struct {
+	log    logrus.FieldLogger
+	config *Config
+	data   map[string]json.RawMessage
+
+	mu sync.RWMutex
+}
+
+func New(ctx context.Context, log logrus.FieldLogger, config *Config) (*File, error) {
+	return &File{
+		log:    log.WithField("store", StoreType).WithField("path", config.Path),
+		config: config,
+		data:   make(map[string]json.RawMessage),
+	}, nil
+}
+
+func (f *File) Start(ctx context.Context) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	raw, err := os.ReadFile(f.config.Path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			f.log.Info("No existing state file found, starting fresh")
+
+			return nil
+		}
+
+		return fmt.Errorf("failed to read state file: %w", err)
+	}
+
+	if len(raw) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(raw, &f.data); err != nil {
+		return fmt.Errorf("failed to parse state file: %w", err)
+	}
+
+	f.log.WithField("keys", len(f.data)).Info("Loaded state file")
+
+	return nil
+}
+
+func (f *File) Stop(ctx context.Context) error {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	return f.flush()
+}
+
+func (f *File) GetType() string {
+	return StoreType
+}
+
+func (f *File) Get(ctx context.Context, key string) ([]byte, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	value, exists := f.data[key]
+	if !exists {
+		return nil, nil
+	}
+
+	return append([]byte(nil), value...), nil
+}
+
+func (f *File) Set(ctx context.Context, key string, value []byte) error {
+	if !json.Valid(value) {
+		return fmt.Errorf("value for key %s is not valid json", key)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.data[key] = append(json.RawMessage(nil), value...)
+
+	return f.flush()
+}
+
+func (f *File) Delete(ctx context.Context, key string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, exists := f.data[key]; !exists {
+		return nil
+	}
+
+	delete(f.data, key)
+
+	return f.flush()
+}
+
+// flush writes the state to a temporary file and renames it over the
+// existing one so a crash mid-write never leaves a truncated file behind.
+func (f *File) flush() error {
+	raw, err := json.MarshalIndent(f.data, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal state: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(f.config.Path), filepath.Base(f.config.Path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary state file: %w", err)
+	}
+
+	if _, err := tmp.Write(raw); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+
+		return fmt.Errorf("failed to write state file: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+
+		return fmt.Errorf("failed to close state file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), f.config.Path); err != nil {
+		os.Remove(tmp.Name())
+
+		return fmt.Errorf("failed to replace state file: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package file_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/store/file"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilePersistsAcrossRestarts(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "state.json")
+
+	f, err := file.New(ctx, logrus.New(), &file.Config{Path: path})
+	require.NoError(t, err)
+	require.NoError(t, f.Start(ctx))
+
+	value, err := f.Get(ctx, "missing")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	require.NoError(t, f.Set(ctx, "key1", []byte(`{"alerting":true}`)))
+	require.NoError(t, f.Set(ctx, "key2", []byte(`{"alerting":false}`)))
+	require.NoError(t, f.Delete(ctx, "key2"))
+	require.NoError(t, f.Stop(ctx))
+
+	reopened, err := file.New(ctx, logrus.New(), &file.Config{Path: path})
+	require.NoError(t, err)
+	require.NoError(t, reopened.Start(ctx))
+
+	value, err = reopened.Get(ctx, "key1")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"alerting":true}`, string(value))
+
+	value, err = reopened.Get(ctx, "key2")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func TestFileRejectsInvalidJSON(t *testing.T) {
+	ctx := context.Background()
+
+	f, err := file.New(ctx, logrus.New(), &file.Config{Path: filepath.Join(t.TempDir(), "state.json")})
+	require.NoError(t, err)
+	require.NoError(t, f.Start(ctx))
+
+	assert.Error(t, f.Set(ctx, "key", []byte("not json")))
+}
+
+func TestFileCorruptState(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "state.json")
+
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0o600))
+
+	f, err := file.New(ctx, logrus.New(), &file.Config{Path: path})
+	require.NoError(t, err)
+
+	assert.Error(t, f.Start(ctx))
+}
+
+func TestConfigValidate(t *testing.T) {
+	assert.NoError(t, (&file.Config{Path: "state.json"}).Validate())
+	assert.Error(t, (&file.Config{}).Validate())
+}
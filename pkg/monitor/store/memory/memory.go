@@ -0,0 +1,67 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+const StoreType = "memory"
+
+// Memory keeps state in memory only, nothing survives a restart.
+type Memory struct {
+	log  logrus.FieldLogger
+	data map[string][]byte
+
+	mu sync.RWMutex
+}
+
+func New(ctx context.Context, log logrus.FieldLogger) (*Memory, error) {
+	return &Memory{
+		log:  log.WithField("store", StoreType),
+		data: make(map[string][]byte),
+	}, nil
+}
+
+func (m *Memory) Start(ctx context.Context) error {
+	return nil
+}
+
+func (m *Memory) Stop(ctx context.Context) error {
+	return nil
+}
+
+func (m *Memory) GetType() string {
+	return StoreType
+}
+
+func (m *Memory) Get(ctx context.Context, key string) ([]byte, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	value, exists := m.data[key]
+	if !exists {
+		return nil, nil
+	}
+
+	return append([]byte(nil), value...), nil
+}
+
+func (m *Memory) Set(ctx context.Context, key string, value []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.data[key] = append([]byte(nil), value...)
+
+	return nil
+}
+
+func (m *Memory) Delete(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.data, key)
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package sqlite
+
+import (
+	"errors"
+	"regexp"
+	"time"
+)
+
+var tableName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+type Config struct {
+	// Path is the SQLite database file.
+	Path string `yaml:"path" default:"splitoor.sqlite"`
+	// Table is the table state is stored in.
+	Table string `yaml:"table" default:"splitoor"`
+	// Timeout is how long to wait for a lock held by another connection.
+	Timeout time.Duration `yaml:"timeout" default:"10s"`
+}
+
+func (c *Config) Validate() error {
+	if c.Path == "" {
+		return errors.New("path is required")
+	}
+
+	if c.Table == "" {
+		return errors.New("table is required")
+	}
+
+	if !tableName.MatchString(c.Table) {
+		return errors.New("table must only contain letters, digits and underscores")
+	}
+
+	return nil
+}
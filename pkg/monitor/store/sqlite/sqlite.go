@@ -0,0 +1,103 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/sirupsen/logrus"
+	_ "modernc.org/sqlite" // registers the sqlite driver
+)
+
+const StoreType = "sqlite"
+
+// SQLite stores state in a key/value table of an embedded SQLite database.
+type SQLite struct {
+	log    logrus.FieldLogger
+	config *Config
+	db     *sql.DB
+}
+
+func New(ctx context.Context, log logrus.FieldLogger, config *Config) (*SQLite, error) {
+	return &SQLite{
+		log:    log.WithField("store", StoreType).WithField("path", config.Path),
+		config: config,
+	}, nil
+}
+
+func (s *SQLite) Start(ctx context.Context) error {
+	dsn := fmt.Sprintf("file:%s?_pragma=%s", s.config.Path, url.QueryEscape(fmt.Sprintf("busy_timeout(%d)", s.config.Timeout.Milliseconds())))
+
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return fmt.Errorf("failed to open sqlite database: %w", err)
+	}
+
+	// a single connection serializes writes, sqlite allows only one writer at a time
+	db.SetMaxOpenConns(1)
+
+	if _, err := db.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS "+s.config.Table+" (key TEXT PRIMARY KEY, value BLOB NOT NULL)"); err != nil {
+		db.Close()
+
+		return fmt.Errorf("failed to create sqlite table: %w", err)
+	}
+
+	s.db = db
+
+	s.log.Info("Opened sqlite database")
+
+	return nil
+}
+
+func (s *SQLite) Stop(ctx context.Context) error {
+	if s.db == nil {
+		return nil
+	}
+
+	return s.db.Close()
+}
+
+func (s *SQLite) GetType() string {
+	return StoreType
+}
+
+func (s *SQLite) Get(ctx context.Context, key string) ([]byte, error) {
+	if s.db == nil {
+		return nil, errors.New("sqlite database is not open")
+	}
+
+	var value []byte
+
+	err := s.db.QueryRowContext(ctx, "SELECT value FROM "+s.config.Table+" WHERE key = ?", key).Scan(&value)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (s *SQLite) Set(ctx context.Context, key string, value []byte) error {
+	if s.db == nil {
+		return errors.New("sqlite database is not open")
+	}
+
+	_, err := s.db.ExecContext(ctx, "INSERT INTO "+s.config.Table+" (key, value) VALUES (?, ?) ON CONFLICT (key) DO UPDATE SET value = excluded.value", key, value)
+
+	return err
+}
+
+func (s *SQLite) Delete(ctx context.Context, key string) error {
+	if s.db == nil {
+		return errors.New("sqlite database is not open")
+	}
+
+	_, err := s.db.ExecContext(ctx, "DELETE FROM "+s.config.Table+" WHERE key = ?", key)
+
+	return err
+}
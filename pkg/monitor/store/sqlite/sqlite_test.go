@@ -0,0 +1,61 @@
+package sqlite_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/splitoor/pkg/monitor/store/sqlite"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQLitePersistsAcrossRestarts(t *testing.T) {
+	ctx := context.Background()
+	config := &sqlite.Config{
+		Path:    filepath.Join(t.TempDir(), "state.sqlite"),
+		Table:   "splitoor",
+		Timeout: time.Second,
+	}
+
+	s, err := sqlite.New(ctx, logrus.New(), config)
+	require.NoError(t, err)
+
+	_, err = s.Get(ctx, "key")
+	assert.Error(t, err, "should error before the database is opened")
+
+	require.NoError(t, s.Start(ctx))
+
+	value, err := s.Get(ctx, "missing")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	require.NoError(t, s.Set(ctx, "key1", []byte("value")))
+	require.NoError(t, s.Set(ctx, "key1", []byte("value1")))
+	require.NoError(t, s.Set(ctx, "key2", []byte("value2")))
+	require.NoError(t, s.Delete(ctx, "key2"))
+	require.NoError(t, s.Stop(ctx))
+
+	reopened, err := sqlite.New(ctx, logrus.New(), config)
+	require.NoError(t, err)
+	require.NoError(t, reopened.Start(ctx))
+
+	defer reopened.Stop(ctx)
+
+	value, err = reopened.Get(ctx, "key1")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value1"), value)
+
+	value, err = reopened.Get(ctx, "key2")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func TestConfigValidate(t *testing.T) {
+	assert.NoError(t, (&sqlite.Config{Path: "state.sqlite", Table: "splitoor"}).Validate())
+	assert.Error(t, (&sqlite.Config{Table: "splitoor"}).Validate())
+	assert.Error(t, (&sqlite.Config{Path: "state.sqlite"}).Validate())
+	assert.Error(t, (&sqlite.Config{Path: "state.sqlite", Table: "state; DROP TABLE x"}).Validate())
+}
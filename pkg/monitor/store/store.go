@@ -0,0 +1,93 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/creasty/defaults"
+	"github.com/ethpandaops/splitoor/pkg/monitor/store/bolt"
+	"github.com/ethpandaops/splitoor/pkg/monitor/store/file"
+	"github.com/ethpandaops/splitoor/pkg/monitor/store/memory"
+	"github.com/ethpandaops/splitoor/pkg/monitor/store/sqlite"
+	"github.com/sirupsen/logrus"
+)
+
+// Store persists monitor state across restarts.
+type Store interface {
+	Start(ctx context.Context) error
+	Stop(ctx context.Context) error
+	GetType() string
+	// Get returns the value stored under key, or nil if it does not exist.
+	Get(ctx context.Context, key string) ([]byte, error)
+	Set(ctx context.Context, key string, value []byte) error
+	Delete(ctx context.Context, key string) error
+}
+
+func NewStore(ctx context.Context, log logrus.FieldLogger, conf *Config) (Store, error) {
+	if conf == nil {
+		return memory.New(ctx, log)
+	}
+
+	switch conf.StoreType {
+	case StoreTypeUnknown:
+		return nil, errors.New("store type is required")
+	case "", StoreTypeMemory:
+		return memory.New(ctx, log)
+	case StoreTypeFile:
+		c := &file.Config{}
+
+		if conf.Config != nil {
+			if err := conf.Config.Unmarshal(c); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := defaults.Set(c); err != nil {
+			return nil, err
+		}
+
+		if err := c.Validate(); err != nil {
+			return nil, err
+		}
+
+		return file.New(ctx, log, c)
+	case StoreTypeBolt:
+		c := &bolt.Config{}
+
+		if conf.Config != nil {
+			if err := conf.Config.Unmarshal(c); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := defaults.Set(c); err != nil {
+			return nil, err
+		}
+
+		if err := c.Validate(); err != nil {
+			return nil, err
+		}
+
+		return bolt.New(ctx, log, c)
+	case StoreTypeSQLite:
+		c := &sqlite.Config{}
+
+		if conf.Config != nil {
+			if err := conf.Config.Unmarshal(c); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := defaults.Set(c); err != nil {
+			return nil, err
+		}
+
+		if err := c.Validate(); err != nil {
+			return nil, err
+		}
+
+		return sqlite.New(ctx, log, c)
+	}
+
+	return nil, errors.New("store type is not supported")
+}